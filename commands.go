@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// commands maps every command line subcommand to the function that runs it.
+var commands = map[string]func(args []string) error{
+	"decrypt": decryptCommand,
+	"signtx":  signTransactionCommand,
+}
+
+func runCommand(name string, args []string) error {
+	command, ok := commands[name]
+	if !ok {
+		names := make([]string, 0, len(commands))
+		for commandName := range commands {
+			names = append(names, commandName)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown command %q, available commands: %s", name, strings.Join(names, ", "))
+	}
+	return command(args)
+}
+
+// decryptCommand decrypts an encrypted export. The WIF is read from stdin
+// rather than a flag so that it does not end up in the shell history.
+func decryptCommand(args []string) error {
+	flags := flag.NewFlagSet("decrypt", flag.ExitOnError)
+	in := flags.String("in", "", "path to the encrypted export")
+	out := flags.String("out", "", "path to write the decrypted CSV to (default stdout)")
+	flags.Parse(args)
+
+	if *in == "" {
+		return errors.New("the -in flag is required")
+	}
+
+	fmt.Fprint(os.Stderr, "Recipient private key (WIF): ")
+	wif, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	rows, err := helper.ReadEncryptedCSV(*in, strings.TrimSpace(wif))
+	if err != nil {
+		return err
+	}
+
+	output := os.Stdout
+	if *out != "" {
+		output, err = os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer output.Close()
+	}
+
+	writer := csv.NewWriter(output)
+	return writer.WriteAll(rows)
+}
+
+// signTransactionCommand signs an unsigned transaction offline. Private keys
+// are loaded from an export or read from stdin, one per line.
+func signTransactionCommand(args []string) error {
+	flags := flag.NewFlagSet("signtx", flag.ExitOnError)
+	txHex := flags.String("tx", "", "unsigned transaction hex")
+	prevOutputsFile := flags.String("prevouts", "", "file with one \"<script hex> <amount in DCR>\" line per input")
+	keysFile := flags.String("keys", "", "dcrseedgen export to load private keys from (default read from stdin)")
+	qrFile := flags.String("qr", "", "path to write the signed transaction as a QR code PNG")
+	flags.Parse(args)
+
+	if *txHex == "" || *prevOutputsFile == "" {
+		return errors.New("the -tx and -prevouts flags are required")
+	}
+
+	prevOutputsText, err := ioutil.ReadFile(*prevOutputsFile)
+	if err != nil {
+		return err
+	}
+	prevOutputs, err := seedgen.ParsePrevOutputs(string(prevOutputsText))
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	if *keysFile != "" {
+		keys, err = helper.ReadPrivateKeysCSV(*keysFile)
+	} else {
+		fmt.Fprintln(os.Stderr, "Private keys (WIF), one per line, end with EOF:")
+		var keysText []byte
+		keysText, err = ioutil.ReadAll(os.Stdin)
+		keys = seedgen.ParsePrivateKeys(string(keysText))
+	}
+	if err != nil {
+		return err
+	}
+
+	signedTx, err := seedgen.SignTransaction(*txHex, prevOutputs, keys)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "signed %d of %d inputs\n", signedTx.SignedInputs, signedTx.TotalInputs)
+	fmt.Println(signedTx.Hex)
+
+	if *qrFile != "" {
+		return qrcode.WriteFile(strings.ToUpper(signedTx.Hex), qrcode.Low, 512, *qrFile)
+	}
+	return nil
+}
@@ -5,12 +5,19 @@ go 1.13
 require (
 	gioui.org v0.0.0-20200618124658-602d54dc5ef7
 	github.com/atotto/clipboard v0.1.2
+	github.com/decred/dcrd/chaincfg/chainhash v1.0.2
 	github.com/decred/dcrd/chaincfg/v2 v2.3.0
 	github.com/decred/dcrd/dcrec v1.0.0
 	github.com/decred/dcrd/dcrec/secp256k1 v1.0.3
 	github.com/decred/dcrd/dcrutil/v2 v2.0.1
+	github.com/decred/dcrd/hdkeychain/v2 v2.0.1
+	github.com/decred/dcrd/txscript/v2 v2.1.0
+	github.com/decred/dcrd/wire v1.3.0
+	github.com/decred/dcrwallet/pgpwordlist v1.0.1
 	github.com/decred/dcrwallet/walletseed v1.0.3
 	github.com/markbates/pkger v0.17.0
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	golang.org/x/exp v0.0.0-20200513190911-00229845015e
 	golang.org/x/image v0.0.0-20200618115811-c13761719519
+	golang.org/x/text v0.3.0
 )
@@ -0,0 +1,146 @@
+package helper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/atotto/clipboard"
+)
+
+// DefaultClipboardTimeout is how long a copied secret stays on the clipboard.
+const DefaultClipboardTimeout = 30 * time.Second
+
+// SecretClipboard copies secrets to the system clipboard and takes them off
+// again once its timeout expires, restoring whatever was on the clipboard
+// before. The clipboard is only touched on expiry if it still holds the
+// secret, so anything the user copied in the meantime is left alone.
+type SecretClipboard struct {
+	mu       sync.Mutex
+	timeout  time.Duration
+	secret   string
+	owner    interface{}
+	previous string
+	expires  time.Time
+	timer    *time.Timer
+	// generation counts the secrets copied, a timer that fired for an
+	// earlier secret while a new one was being copied does nothing.
+	generation uint64
+
+	// read and write access the system clipboard.
+	read  func() (string, error)
+	write func(string) error
+}
+
+func NewSecretClipboard(timeout time.Duration) *SecretClipboard {
+	return &SecretClipboard{
+		timeout: timeout,
+		read:    clipboard.ReadAll,
+		write:   clipboard.WriteAll,
+	}
+}
+
+// SetTimeout changes the timeout used for secrets copied from now on.
+func (c *SecretClipboard) SetTimeout(timeout time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.timeout = timeout
+}
+
+// Copy puts secret on the clipboard and starts the timer that removes it.
+// owner identifies what the secret was copied from, e.g. its *seedgen.Seed,
+// so that Remaining can be asked about it without turning the secret into a
+// string again. It must be comparable.
+func (c *SecretClipboard) Copy(owner interface{}, secret string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// only remember what was there before the first secret so that copying
+	// several secrets in a row doesn't restore one of them
+	previous := c.previous
+	if c.timer == nil {
+		previous, _ = c.read()
+	}
+
+	err := c.write(secret)
+	if err != nil {
+		// a secret copied earlier may still be on the clipboard, it is
+		// still cleared when its time is up
+		return err
+	}
+
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.generation++
+	generation := c.generation
+	c.secret = secret
+	c.owner = owner
+	c.previous = previous
+	c.expires = time.Now().Add(c.timeout)
+	c.timer = time.AfterFunc(c.timeout, func() {
+		c.expire(generation)
+	})
+	return nil
+}
+
+// Clear takes the secret off the clipboard right away, e.g. when exiting.
+func (c *SecretClipboard) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clear()
+}
+
+// expire clears the secret copied as generation, unless another secret has
+// been copied since. Stopping the timer in Copy doesn't help once it has
+// fired and is waiting for the lock.
+func (c *SecretClipboard) expire(generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.clear()
+}
+
+func (c *SecretClipboard) clear() {
+	if c.timer == nil {
+		return
+	}
+	c.timer.Stop()
+
+	current, err := c.read()
+	if err == nil && current == c.secret {
+		c.write(c.previous)
+	}
+	c.reset()
+}
+
+func (c *SecretClipboard) reset() {
+	c.secret = ""
+	c.owner = nil
+	c.previous = ""
+	c.expires = time.Time{}
+	c.timer = nil
+}
+
+// Active reports whether a copied secret is waiting to be cleared.
+func (c *SecretClipboard) Active() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.timer != nil
+}
+
+// Remaining returns how long the secret copied from owner has left on the
+// clipboard, or zero if it is not the secret currently being tracked.
+func (c *SecretClipboard) Remaining(owner interface{}) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.timer == nil || owner == nil || c.owner != owner {
+		return 0
+	}
+	if remaining := time.Until(c.expires); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
@@ -0,0 +1,165 @@
+package helper
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClipboard stands in for the system clipboard, which isn't available
+// where tests run.
+type fakeClipboard struct {
+	mu      sync.Mutex
+	content string
+	// fail makes writing fail, as when no clipboard is available.
+	fail bool
+}
+
+func (f *fakeClipboard) read() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.content, nil
+}
+
+func (f *fakeClipboard) write(content string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail {
+		return errors.New("no clipboard")
+	}
+	f.content = content
+	return nil
+}
+
+// owners returns the same owner token for every name, standing in for the
+// seeds and key pairs secrets are copied from.
+var owners = make(map[string]*struct{ name string })
+
+func owner(name string) interface{} {
+	if owners[name] == nil {
+		owners[name] = &struct{ name string }{name}
+	}
+	return owners[name]
+}
+
+func newTestClipboard(timeout time.Duration, content string) (*SecretClipboard, *fakeClipboard) {
+	fake := &fakeClipboard{content: content}
+	c := NewSecretClipboard(timeout)
+	c.read = fake.read
+	c.write = fake.write
+	return c, fake
+}
+
+func TestSecretClipboardExpiry(t *testing.T) {
+	c, fake := newTestClipboard(20*time.Millisecond, "before")
+
+	if err := c.Copy(owner("secret"), "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := fake.read(); content != "secret" {
+		t.Fatalf("clipboard: got %q, want the secret", content)
+	}
+	if !c.Active() || c.Remaining(owner("secret")) <= 0 {
+		t.Error("the copied secret is not tracked")
+	}
+	if c.Remaining(owner("other")) != 0 {
+		t.Error("a secret that wasn't copied has time left")
+	}
+	if c.Remaining(nil) != 0 {
+		t.Error("no owner has time left")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for c.Active() && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if c.Active() {
+		t.Fatal("the secret did not expire")
+	}
+	if content, _ := fake.read(); content != "before" {
+		t.Errorf("clipboard after expiry: got %q, want %q", content, "before")
+	}
+}
+
+func TestSecretClipboard(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(c *SecretClipboard, fake *fakeClipboard)
+		want string
+	}{
+		{
+			name: "clear restores the clipboard",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				c.Copy(owner("secret"), "secret")
+				c.Clear()
+			},
+			want: "before",
+		},
+		{
+			name: "copying twice restores what was there before the first",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				c.Copy(owner("first"), "first")
+				c.Copy(owner("second"), "second")
+				c.Clear()
+			},
+			want: "before",
+		},
+		{
+			name: "something copied in the meantime is left alone",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				c.Copy(owner("secret"), "secret")
+				fake.write("mine")
+				c.Clear()
+			},
+			want: "mine",
+		},
+		{
+			name: "a failed copy keeps the earlier secret tracked",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				c.Copy(owner("first"), "first")
+				fake.fail = true
+				if c.Copy(owner("second"), "second") == nil {
+					t.Error("a failed copy reported no error")
+				}
+				fake.fail = false
+				if c.Remaining(owner("first")) <= 0 {
+					t.Error("the earlier secret is no longer tracked")
+				}
+				c.Clear()
+			},
+			want: "before",
+		},
+		{
+			name: "a failed first copy tracks nothing",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				fake.fail = true
+				c.Copy(owner("secret"), "secret")
+				fake.fail = false
+				if c.Active() {
+					t.Error("a secret that wasn't copied is tracked")
+				}
+			},
+			want: "before",
+		},
+		{
+			name: "a timer of an earlier secret firing late does nothing",
+			run: func(c *SecretClipboard, fake *fakeClipboard) {
+				c.Copy(owner("first"), "first")
+				stale := c.generation
+				c.Copy(owner("second"), "second")
+				c.expire(stale)
+			},
+			want: "second",
+		},
+	}
+
+	for _, test := range tests {
+		c, fake := newTestClipboard(time.Hour, "before")
+		test.run(c, fake)
+		if content, _ := fake.read(); content != test.want {
+			t.Errorf("%s: clipboard: got %q, want %q", test.name, content, test.want)
+		}
+		c.Clear()
+	}
+}
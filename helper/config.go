@@ -0,0 +1,391 @@
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+const (
+	configFileName = "config.json"
+
+	// themeFileName is where the theme was saved before there was a config
+	// file, it is read once when migrating.
+	themeFileName = "theme"
+
+	configDirPerm  os.FileMode = 0700
+	configFilePerm os.FileMode = 0600
+
+	// ConfigVersion is the version of the config file written by this build.
+	// Bump it and add a migration whenever the meaning of a setting changes.
+	ConfigVersion = 1
+)
+
+const (
+	ExportFormatCSV          = "csv"
+	ExportFormatEncryptedCSV = "encrypted-csv"
+
+	// VerifyAllWords asks for every seed word when verifying a seed,
+	// VerifySomeWords only for a few picked at random.
+	VerifyAllWords  = "all"
+	VerifySomeWords = "random"
+)
+
+// Duration is a time.Duration written to the config file as a string such
+// as "30s".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Config holds the settings that are kept between runs.
+type Config struct {
+	Version            int      `json:"version"`
+	DefaultNetwork     string   `json:"defaultNetwork"`
+	SeedSize           int      `json:"seedSize"`
+	ExportFormat       string   `json:"exportFormat"`
+	ExportRecipientKey string   `json:"exportRecipientKey,omitempty"`
+	ExportDirectory    string   `json:"exportDirectory,omitempty"`
+	ClipboardTimeout   Duration `json:"clipboardTimeout"`
+	// Theme is the name of a palette, one of those passed to
+	// SetConfigChoices.
+	Theme            string `json:"theme"`
+	VerificationMode string `json:"verificationMode"`
+	// Language is the tag of the language the user interface is shown in,
+	// one of those passed to SetConfigChoices, or empty to follow the
+	// system language.
+	Language string `json:"language,omitempty"`
+	// ReduceMotion turns off the animations between pages and views.
+	ReduceMotion bool `json:"reduceMotion,omitempty"`
+}
+
+// DefaultConfig returns the settings used when nothing was saved yet.
+func DefaultConfig() Config {
+	return Config{
+		Version:          ConfigVersion,
+		DefaultNetwork:   string(seedgen.Testnet3),
+		SeedSize:         seedgen.DefaultSeedSize,
+		ExportFormat:     ExportFormatCSV,
+		ClipboardTimeout: Duration(DefaultClipboardTimeout),
+		Theme:            "light",
+		VerificationMode: VerifyAllWords,
+	}
+}
+
+// configSetting validates one setting and resets it to its default.
+type configSetting struct {
+	validate func(cfg *Config) error
+	reset    func(cfg *Config, defaults Config)
+}
+
+var configSettings = []configSetting{
+	{
+		validate: func(cfg *Config) error {
+			_, err := seedgen.ParseNetwork(cfg.DefaultNetwork)
+			return err
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.DefaultNetwork = defaults.DefaultNetwork },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.SeedSize < seedgen.MinSeedSize || cfg.SeedSize > seedgen.MaxSeedSize {
+				return fmt.Errorf("seed size must be between %d and %d bytes", seedgen.MinSeedSize, seedgen.MaxSeedSize)
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.SeedSize = defaults.SeedSize },
+	},
+	// the recipient key is checked before the export format that needs
+	// it, so that resetting a bad key also resets the format
+	{
+		validate: func(cfg *Config) error {
+			if cfg.ExportRecipientKey == "" {
+				return nil
+			}
+			_, err := seedgen.ParsePublicKey(cfg.ExportRecipientKey)
+			return err
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.ExportRecipientKey = defaults.ExportRecipientKey },
+	},
+	{
+		validate: func(cfg *Config) error {
+			switch cfg.ExportFormat {
+			case ExportFormatCSV:
+				return nil
+			case ExportFormatEncryptedCSV:
+				if cfg.ExportRecipientKey == "" {
+					return fmt.Errorf("encrypted exports need a recipient public key")
+				}
+				return nil
+			default:
+				return fmt.Errorf("unknown export format %q", cfg.ExportFormat)
+			}
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.ExportFormat = defaults.ExportFormat },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.ExportDirectory != "" && !filepath.IsAbs(cfg.ExportDirectory) {
+				return fmt.Errorf("export location %s is not an absolute path", cfg.ExportDirectory)
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.ExportDirectory = defaults.ExportDirectory },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.ClipboardTimeout <= 0 {
+				return fmt.Errorf("clipboard timeout must be positive")
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.ClipboardTimeout = defaults.ClipboardTimeout },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.Theme == "" {
+				return fmt.Errorf("no theme chosen")
+			}
+			if !configChoice(cfg.Theme, func() []string { return themeNames }) {
+				return fmt.Errorf("unknown theme %q", cfg.Theme)
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.Theme = defaults.Theme },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.VerificationMode != VerifyAllWords && cfg.VerificationMode != VerifySomeWords {
+				return fmt.Errorf("unknown verification mode %q", cfg.VerificationMode)
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.VerificationMode = defaults.VerificationMode },
+	},
+	{
+		validate: func(cfg *Config) error {
+			if cfg.Language != "" && !configChoice(cfg.Language, func() []string { return languageTags }) {
+				return fmt.Errorf("unknown language %q", cfg.Language)
+			}
+			return nil
+		},
+		reset: func(cfg *Config, defaults Config) { cfg.Language = defaults.Language },
+	},
+}
+
+var (
+	configChoicesMu sync.Mutex
+	themeNames      []string
+	languageTags    []string
+)
+
+// SetConfigChoices sets the palettes the Theme setting and the language tags
+// the Language setting may name. Both are defined by the user interface,
+// which has to set them before loading the config.
+func SetConfigChoices(themes, languages []string) {
+	configChoicesMu.Lock()
+	defer configChoicesMu.Unlock()
+	themeNames = themes
+	languageTags = languages
+}
+
+// configChoice reports whether value is one of the choices returned by
+// choices. Nothing is rejected before the choices are set.
+func configChoice(value string, choices func() []string) bool {
+	configChoicesMu.Lock()
+	defer configChoicesMu.Unlock()
+	list := choices()
+	if list == nil {
+		return true
+	}
+	for _, choice := range list {
+		if choice == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns the first setting that is not valid.
+func (cfg *Config) Validate() error {
+	for _, setting := range configSettings {
+		err := setting.validate(cfg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyDefaults resets every setting that is not valid to its default and
+// returns what was wrong with them.
+func (cfg *Config) applyDefaults() []string {
+	defaults := DefaultConfig()
+	var problems []string
+	for _, setting := range configSettings {
+		err := setting.validate(cfg)
+		if err != nil {
+			problems = append(problems, err.Error())
+			setting.reset(cfg, defaults)
+		}
+	}
+
+	// the settings depend on each other, fall back to the defaults rather
+	// than return a config that can't be saved
+	err := cfg.Validate()
+	if err != nil {
+		problems = append(problems, err.Error())
+		*cfg = defaults
+	}
+	return problems
+}
+
+// configMigrations upgrade a config from the version at their index to the
+// next one.
+var configMigrations = []func(cfg *Config){
+	// 0 -> 1: the theme used to be saved in a file of its own
+	func(cfg *Config) {
+		if cfg.Theme != "" {
+			return
+		}
+		cfg.Theme = DefaultConfig().Theme
+		dir, err := ConfigDirectory()
+		if err != nil {
+			return
+		}
+		name, err := ioutil.ReadFile(filepath.Join(dir, themeFileName))
+		if err == nil {
+			cfg.Theme = strings.TrimSpace(string(name))
+		}
+	},
+}
+
+// ConfigDirectory returns the directory dcrseedgen keeps its settings in,
+// inside the user's configuration directory.
+func ConfigDirectory() (string, error) {
+	configHome, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configHome, appDataDirName), nil
+}
+
+// ConfigFile returns the path of the config file.
+func ConfigFile() (string, error) {
+	dir, err := ConfigDirectory()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+// LoadConfig reads the saved settings, or returns the defaults if there are
+// none. Older config files are migrated to the current version. The config
+// returned can always be used, settings that are not valid are replaced by
+// their defaults and reported in the error.
+func LoadConfig() (*Config, error) {
+	cfg := DefaultConfig()
+
+	filename, err := ConfigFile()
+	if err != nil {
+		return &cfg, err
+	}
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		// nothing saved yet, but there may be a theme from before config
+		// files, which migrating an empty config picks up
+		data, err = []byte("{}"), nil
+	}
+	if err != nil {
+		return &cfg, err
+	}
+
+	// settings missing from the file keep their defaults, except for the
+	// version and those the migrations fill in
+	cfg.Version = 0
+	cfg.Theme = ""
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		defaults := DefaultConfig()
+		return &defaults, fmt.Errorf("error reading %s: %s", filename, err.Error())
+	}
+	if cfg.Version > ConfigVersion {
+		defaults := DefaultConfig()
+		return &defaults, fmt.Errorf("%s was written by a newer version of dcrseedgen (version %d), using the default settings",
+			filename, cfg.Version)
+	}
+
+	for version := cfg.Version; version < ConfigVersion; version++ {
+		configMigrations[version](&cfg)
+	}
+	cfg.Version = ConfigVersion
+
+	problems := cfg.applyDefaults()
+	if len(problems) > 0 {
+		return &cfg, fmt.Errorf("invalid settings in %s were reset to their defaults: %s", filename, strings.Join(problems, "; "))
+	}
+	return &cfg, nil
+}
+
+// SaveConfig validates cfg and writes it to the config file.
+func SaveConfig(cfg *Config) error {
+	err := cfg.Validate()
+	if err != nil {
+		return err
+	}
+	cfg.Version = ConfigVersion
+
+	dir, err := ConfigDirectory()
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(dir, configDirPerm)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a failed write doesn't leave a
+	// truncated config behind
+	filename := filepath.Join(dir, configFileName)
+	tmp := filename + ".tmp"
+	err = ioutil.WriteFile(tmp, append(data, '\n'), configFilePerm)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp, filename)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	// the theme now lives in the config file
+	os.Remove(filepath.Join(dir, themeFileName))
+	return nil
+}
@@ -0,0 +1,205 @@
+package helper
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+// generatorKey is the compressed public key of private key 1.
+const generatorKey = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+
+// useTempConfigDir points the config directory at a fresh directory and sets
+// the theme and language choices until the returned function is called.
+func useTempConfigDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "dcrseedgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldConfigHome, hadConfigHome := os.LookupEnv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	SetConfigChoices([]string{"light", "dark"}, []string{"en", "es"})
+
+	configDir, err := ConfigDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return configDir, func() {
+		SetConfigChoices(nil, nil)
+		if hadConfigHome {
+			os.Setenv("XDG_CONFIG_HOME", oldConfigHome)
+		} else {
+			os.Unsetenv("XDG_CONFIG_HOME")
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+// writeConfigFile writes data to name in the config directory dir.
+func writeConfigFile(t *testing.T, dir, name, data string) {
+	err := os.MkdirAll(dir, configDirPerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, name), []byte(data), configFilePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	_, cleanup := useTempConfigDir(t)
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		change func(cfg *Config)
+		valid  bool
+	}{
+		{"defaults", func(cfg *Config) {}, true},
+		{"mainnet", func(cfg *Config) { cfg.DefaultNetwork = string(seedgen.Mainnet) }, true},
+		{"unknown network", func(cfg *Config) { cfg.DefaultNetwork = "simnet2" }, false},
+		{"seed too small", func(cfg *Config) { cfg.SeedSize = 8 }, false},
+		{"unknown export format", func(cfg *Config) { cfg.ExportFormat = "xml" }, false},
+		{"encrypted export", func(cfg *Config) {
+			cfg.ExportFormat = ExportFormatEncryptedCSV
+			cfg.ExportRecipientKey = generatorKey
+		}, true},
+		{"encrypted export without key", func(cfg *Config) { cfg.ExportFormat = ExportFormatEncryptedCSV }, false},
+		{"bad recipient key", func(cfg *Config) { cfg.ExportRecipientKey = "02ff" }, false},
+		{"relative export directory", func(cfg *Config) { cfg.ExportDirectory = "exports" }, false},
+		{"no clipboard timeout", func(cfg *Config) { cfg.ClipboardTimeout = 0 }, false},
+		{"dark theme", func(cfg *Config) { cfg.Theme = "dark" }, true},
+		{"no theme", func(cfg *Config) { cfg.Theme = "" }, false},
+		{"unknown theme", func(cfg *Config) { cfg.Theme = "solarized" }, false},
+		{"unknown verification mode", func(cfg *Config) { cfg.VerificationMode = "none" }, false},
+		{"system language", func(cfg *Config) { cfg.Language = "" }, true},
+		{"spanish", func(cfg *Config) { cfg.Language = "es" }, true},
+		{"unknown language", func(cfg *Config) { cfg.Language = "xx" }, false},
+	}
+
+	for _, test := range tests {
+		cfg := DefaultConfig()
+		test.change(&cfg)
+		err := cfg.Validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got %v, want valid %v", test.name, err, test.valid)
+		}
+	}
+}
+
+func TestLoadConfigResetsInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want func(cfg *Config)
+	}{
+		{
+			name: "encrypted export with a bad key",
+			data: `{"version": 1, "exportFormat": "encrypted-csv", "exportRecipientKey": "not a key", "theme": "dark"}`,
+			want: func(cfg *Config) { cfg.Theme = "dark" },
+		},
+		{
+			name: "unknown theme and language",
+			data: `{"version": 1, "defaultNetwork": "Mainnet", "theme": "solarized", "language": "xx"}`,
+			want: func(cfg *Config) { cfg.DefaultNetwork = string(seedgen.Mainnet) },
+		},
+		{
+			name: "no clipboard timeout",
+			data: `{"version": 1, "clipboardTimeout": "0s", "theme": "light", "language": "es"}`,
+			want: func(cfg *Config) { cfg.Language = "es" },
+		},
+	}
+
+	for _, test := range tests {
+		func() {
+			dir, cleanup := useTempConfigDir(t)
+			defer cleanup()
+			writeConfigFile(t, dir, configFileName, test.data)
+
+			cfg, err := LoadConfig()
+			if err == nil {
+				t.Errorf("%s: no error reported", test.name)
+			}
+			want := DefaultConfig()
+			test.want(&want)
+			if *cfg != want {
+				t.Errorf("%s: got %+v, want %+v", test.name, *cfg, want)
+			}
+			err = SaveConfig(cfg)
+			if err != nil {
+				t.Errorf("%s: saving the loaded config: %v", test.name, err)
+			}
+		}()
+	}
+}
+
+func TestLoadConfigMigration(t *testing.T) {
+	dir, cleanup := useTempConfigDir(t)
+	defer cleanup()
+	writeConfigFile(t, dir, themeFileName, "dark\n")
+	writeConfigFile(t, dir, configFileName, `{"defaultNetwork": "Mainnet", "clipboardTimeout": "1m0s"}`)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DefaultConfig()
+	want.DefaultNetwork = string(seedgen.Mainnet)
+	want.ClipboardTimeout = Duration(time.Minute)
+	want.Theme = "dark"
+	if *cfg != want {
+		t.Errorf("got %+v, want %+v", *cfg, want)
+	}
+
+	err = SaveConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = os.Stat(filepath.Join(dir, themeFileName))
+	if !os.IsNotExist(err) {
+		t.Errorf("theme file: got %v, want it removed", err)
+	}
+
+	saved, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *saved != want {
+		t.Errorf("saved: got %+v, want %+v", *saved, want)
+	}
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{"no config file", "", false},
+		{"newer version", `{"version": 99, "theme": "dark"}`, true},
+		{"not json", `{"version": `, true},
+	}
+
+	for _, test := range tests {
+		func() {
+			dir, cleanup := useTempConfigDir(t)
+			defer cleanup()
+			if test.data != "" {
+				writeConfigFile(t, dir, configFileName, test.data)
+			}
+
+			cfg, err := LoadConfig()
+			if (err != nil) != test.wantErr {
+				t.Errorf("%s: got error %v, want error %v", test.name, err, test.wantErr)
+			}
+			if *cfg != DefaultConfig() {
+				t.Errorf("%s: got %+v, want the defaults", test.name, *cfg)
+			}
+		}()
+	}
+}
@@ -0,0 +1,11 @@
+package helper
+
+import (
+	"syscall"
+)
+
+// DisableCoreDumps stops the process from writing core dumps, which would
+// contain any seeds and private keys in memory at the time of a crash.
+func DisableCoreDumps() error {
+	return syscall.Setrlimit(syscall.RLIMIT_CORE, &syscall.Rlimit{})
+}
@@ -0,0 +1,21 @@
+package helper
+
+import (
+	"syscall"
+)
+
+// DisableCoreDumps stops the process from writing core dumps, which would
+// contain any seeds and private keys in memory at the time of a crash.
+func DisableCoreDumps() error {
+	err := syscall.Setrlimit(syscall.RLIMIT_CORE, &syscall.Rlimit{})
+	if err != nil {
+		return err
+	}
+
+	// also keeps other processes of the same user from attaching to this one
+	_, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, syscall.PR_SET_DUMPABLE, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package helper
+
+// DisableCoreDumps is a no-op on platforms without core dump limits.
+func DisableCoreDumps() error {
+	return nil
+}
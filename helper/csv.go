@@ -4,49 +4,111 @@ import (
 	"encoding/csv"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
-)
 
-type Message struct {
-	Message string
-	Variant string
-}
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
 
 const (
-	exportDir            = "./exports"
 	exportFilenamePrefix = "dcrseedgen_"
+
+	// exportTimestampFormat avoids ':' which is not allowed in filenames on
+	// some filesystems.
+	exportTimestampFormat = "2006-01-02_15-04-05"
+
+	// maxExportAttempts is how many exports made within the same second get
+	// a file of their own.
+	maxExportAttempts = 100
 )
 
-func CreateDataDirectory() error {
-	_, err := os.Stat(exportDir)
-	if err == nil {
-		return nil
+// exportFilename returns the name of an export made at t, attempt counts the
+// exports that were already made in the same second.
+func exportFilename(t time.Time, attempt int, extension string) string {
+	name := exportFilenamePrefix + t.Format(exportTimestampFormat)
+	if attempt > 0 {
+		name += "_" + strconv.Itoa(attempt)
+	}
+	return filepath.Join(exportDir, name+extension)
+}
+
+// createExportFile creates a new export file that only the current user can
+// read, making sure the export directory is safe to write to first.
+func createExportFile(extension string) (*os.File, error) {
+	err := CreateDataDirectory()
+	if err != nil {
+		return nil, err
 	}
 
-	if os.IsNotExist(err) {
-		os.Mkdir(exportDir, os.ModePerm)
-		return nil
+	now := time.Now()
+	for attempt := 0; ; attempt++ {
+		file, err := os.OpenFile(exportFilename(now, attempt, extension), os.O_WRONLY|os.O_CREATE|os.O_EXCL, exportFilePerm)
+		if !os.IsExist(err) || attempt == maxExportAttempts-1 {
+			return file, err
+		}
 	}
-	return err
 }
 
-func CreateCSV(data [][]string) (string, error) {
-	filename := filepath.Join(exportDir, exportFilenamePrefix+time.Now().Format("2006-01-02_15:04:05")+".csv")
-	file, err := os.Create(filename)
+// CreateExport writes pairs to a new export file using exporter and returns
+// its path.
+func CreateExport(exporter seedgen.Exporter, pairs []*seedgen.KeyPair) (string, error) {
+	file, err := createExportFile(exporter.Extension())
 	if err != nil {
 		return "", err
 	}
 	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+	err = seedgen.ExportKeyPairs(file, exporter, pairs)
+	if err != nil {
+		return "", err
+	}
+	fp, _ := filepath.Abs(file.Name())
+	return fp, nil
+}
 
-	for _, value := range data {
-		err := writer.Write(value)
-		if err != nil {
-			return "", err
+// ReadEncryptedCSV decrypts an encrypted export with the WIF of the
+// recipient and returns its rows.
+func ReadEncryptedCSV(filename, wif string) ([][]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return seedgen.DecryptExport(file, wif)
+}
+
+// ReadUTXOFile reads the outputs to sweep from a CSV file, see
+// seedgen.ParseUTXOs for the format.
+func ReadUTXOFile(filename string) ([]seedgen.UTXO, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return seedgen.ParseUTXOs(file)
+}
+
+// ReadPrivateKeysCSV returns the private keys of a plaintext dcrseedgen
+// export, which may have been copied anywhere, e.g. to an offline machine.
+func ReadPrivateKeysCSV(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var wifs []string
+	for _, row := range withoutHeader(rows) {
+		if len(row) > 1 {
+			wifs = append(wifs, row[1])
 		}
 	}
-	fp, _ := filepath.Abs(filename)
-	return fp, nil
+	return wifs, nil
 }
@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+// useTempExportDir points exports at a fresh directory until the returned
+// function is called.
+func useTempExportDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "dcrseedgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportsDir := filepath.Join(dir, "exports")
+	SetExportDirectory(exportsDir)
+	return exportsDir, func() {
+		SetExportDirectory("")
+		os.RemoveAll(dir)
+	}
+}
+
+func TestCreateExportFileSameSecond(t *testing.T) {
+	_, cleanup := useTempExportDir(t)
+	defer cleanup()
+
+	names := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		file, err := createExportFile(".csv")
+		if err != nil {
+			t.Fatalf("export %d: %v", i, err)
+		}
+		file.Close()
+		if names[file.Name()] {
+			t.Fatalf("export %d: %s was written twice", i, file.Name())
+		}
+		names[file.Name()] = true
+	}
+}
+
+func TestReadEncryptedCSV(t *testing.T) {
+	_, cleanup := useTempExportDir(t)
+	defer cleanup()
+
+	one := append(make([]byte, 31), 0x01)
+	recipient, err := seedgen.GenerateKeyPair(seedgen.Mainnet, seedgen.GenerateOptions{Entropy: bytes.NewReader(one)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := CreateExport(seedgen.EncryptedCSVExporter{RecipientKey: recipient.PublicKey}, []*seedgen.KeyPair{recipient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(path, ".csv.enc") {
+		t.Errorf("path %s does not have the encrypted extension", path)
+	}
+
+	rows, err := ReadEncryptedCSV(path, recipient.WIF())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{seedgen.KeyPairCSVHeader, recipient.CSVRecord()}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows: got %v, want %v", rows, want)
+	}
+
+	_, err = ReadEncryptedCSV(path+".missing", recipient.WIF())
+	if !os.IsNotExist(err) {
+		t.Errorf("missing file: got %v, want a not exist error", err)
+	}
+}
@@ -0,0 +1,90 @@
+package helper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	appDataDirName = "dcrseedgen"
+
+	exportDirPerm  os.FileMode = 0700
+	exportFilePerm os.FileMode = 0600
+)
+
+var exportDir = DefaultExportDirectory()
+
+// DefaultExportDirectory returns the exports folder inside the XDG data
+// directory, falling back to the platform's conventional location for
+// application data when XDG_DATA_HOME is not set.
+func DefaultExportDirectory() string {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return filepath.Join(".", "exports")
+		}
+
+		switch runtime.GOOS {
+		case "windows":
+			dataHome = os.Getenv("LOCALAPPDATA")
+			if dataHome == "" {
+				dataHome = filepath.Join(home, "AppData", "Local")
+			}
+		case "darwin":
+			dataHome = filepath.Join(home, "Library", "Application Support")
+		default:
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+	}
+	return filepath.Join(dataHome, appDataDirName, "exports")
+}
+
+// ExportDirectory returns the directory exports are currently written to.
+func ExportDirectory() string {
+	return exportDir
+}
+
+// SetExportDirectory changes the directory exports are written to. An empty
+// dir restores the default.
+func SetExportDirectory(dir string) {
+	if dir == "" {
+		dir = DefaultExportDirectory()
+	}
+	exportDir = dir
+}
+
+// CreateDataDirectory creates the export directory, readable only by the
+// current user, if it does not exist yet and refuses to use an existing one
+// that other users can read.
+func CreateDataDirectory() error {
+	info, err := os.Stat(exportDir)
+	if os.IsNotExist(err) {
+		return os.MkdirAll(exportDir, exportDirPerm)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("export location %s is not a directory", exportDir)
+	}
+	return checkDirectoryPermissions(exportDir, info.Mode())
+}
+
+// checkDirectoryPermissions rejects directories that any user on the system
+// can list or read. Windows does not report unix permission bits so the check
+// is skipped there.
+func checkDirectoryPermissions(dir string, mode os.FileMode) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	if mode.Perm()&0007 != 0 {
+		return fmt.Errorf("export directory %s is accessible by other users (mode %s), "+
+			"restrict it with chmod 700 or choose another location", dir, mode.Perm())
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+func TestCreateDataDirectoryModes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows does not report unix permission bits")
+	}
+	dir, cleanup := useTempExportDir(t)
+	defer cleanup()
+
+	file, err := createExportFile(".csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.IsDir() || info.Mode().Perm() != exportDirPerm {
+		t.Errorf("directory mode: got %s, want %s", info.Mode(), os.ModeDir|exportDirPerm)
+	}
+
+	info, err = os.Stat(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != exportFilePerm {
+		t.Errorf("file mode: got %s, want %s", info.Mode().Perm(), exportFilePerm)
+	}
+
+	// the directory now exists and is private, so it is used as it is
+	err = CreateDataDirectory()
+	if err != nil {
+		t.Errorf("existing directory: %v", err)
+	}
+}
+
+func TestCreateDataDirectoryReadableByOthers(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows does not report unix permission bits")
+	}
+	dir, cleanup := useTempExportDir(t)
+	defer cleanup()
+
+	tests := []struct {
+		mode    os.FileMode
+		wantErr bool
+	}{
+		{0700, false},
+		{0750, false},
+		{0755, true},
+		{0701, true},
+		{0777, true},
+	}
+
+	err := os.Mkdir(dir, exportDirPerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		err := os.Chmod(dir, test.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = CreateDataDirectory()
+		if (err != nil) != test.wantErr {
+			t.Errorf("mode %s: got error %v, want error %v", test.mode, err, test.wantErr)
+		}
+	}
+
+	_, err = createExportFile(".csv")
+	if err == nil {
+		t.Error("an export was written to a directory other users can read")
+	}
+}
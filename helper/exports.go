@@ -0,0 +1,202 @@
+package helper
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+var encryptedExportExtension = seedgen.EncryptedCSVExporter{}.Extension()
+
+// ExportFile describes a file previously written to the export directory.
+type ExportFile struct {
+	Name    string
+	Path    string
+	Size    int64
+	ModTime time.Time
+
+	// Encrypted exports can not be inspected without the recipient's key so
+	// Rows is -1 and Network is empty for them.
+	Encrypted bool
+	Rows      int
+	Network   string
+}
+
+// ListExports returns the exports found in the export directory, newest
+// first.
+func ListExports() ([]ExportFile, error) {
+	infos, err := ioutil.ReadDir(exportDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var exports []ExportFile
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasPrefix(info.Name(), exportFilenamePrefix) {
+			continue
+		}
+
+		export := ExportFile{
+			Name:      info.Name(),
+			Path:      filepath.Join(exportDir, info.Name()),
+			Size:      info.Size(),
+			ModTime:   info.ModTime(),
+			Encrypted: strings.HasSuffix(info.Name(), encryptedExportExtension),
+			Rows:      -1,
+		}
+
+		if !export.Encrypted {
+			rows, err := ReadExport(export.Path)
+			if err == nil {
+				rows = withoutHeader(rows)
+				export.Rows = len(rows)
+				export.Network = exportNetwork(rows)
+			}
+		}
+		exports = append(exports, export)
+	}
+
+	sort.Slice(exports, func(i, j int) bool {
+		return exports[i].ModTime.After(exports[j].ModTime)
+	})
+	return exports, nil
+}
+
+// withoutHeader drops the KeyPairCSVHeader row that exports written since
+// the public key columns were added start with.
+func withoutHeader(rows [][]string) [][]string {
+	if len(rows) > 0 && len(rows[0]) > 0 && rows[0][0] == seedgen.KeyPairCSVHeader[0] {
+		return rows[1:]
+	}
+	return rows
+}
+
+// exportNetwork guesses the network of an export from the address prefix of
+// its first row.
+func exportNetwork(rows [][]string) string {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return ""
+	}
+	return string(seedgen.NetworkFromAddress(rows[0][0]))
+}
+
+// ReadExport returns the rows of a plaintext export.
+func ReadExport(path string) ([][]string, error) {
+	if err := checkExportPath(path); err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path, encryptedExportExtension) {
+		return nil, errors.New("export is encrypted, decrypt it with the recipient's private key")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return csv.NewReader(file).ReadAll()
+}
+
+// RevealExport opens the platform file manager with the export selected, or
+// at least its directory shown where selecting is not supported.
+func RevealExport(path string) error {
+	if err := checkExportPath(path); err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("explorer", "/select,", path)
+	case "darwin":
+		cmd = exec.Command("open", "-R", path)
+	default:
+		cmd = exec.Command("xdg-open", filepath.Dir(path))
+	}
+	return cmd.Start()
+}
+
+// SecureDeleteExport overwrites an export with random data, flushes it to
+// disk and then removes it. Journaling filesystems and SSD wear levelling may
+// still keep copies of the old blocks, so this reduces rather than eliminates
+// the chance of recovery.
+func SecureDeleteExport(path string) error {
+	if err := checkExportPath(path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	_, err = io.CopyN(file, rand.Reader, info.Size())
+	if err == nil {
+		err = syncExport(file)
+	}
+	if err == nil {
+		err = file.Truncate(0)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return removeExport(path)
+}
+
+// syncExport and removeExport are replaced by tests to check that an export
+// is overwritten before it is removed.
+var (
+	syncExport   = (*os.File).Sync
+	removeExport = os.Remove
+)
+
+// checkExportPath makes sure path points to a file directly inside the export
+// directory, and not to a link out of it, so that the export manager can not
+// be used on arbitrary files.
+func checkExportPath(path string) error {
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	exportDirAbs, err := filepath.Abs(exportDir)
+	if err != nil {
+		return err
+	}
+
+	if dir != exportDirAbs || !strings.HasPrefix(filepath.Base(path), exportFilenamePrefix) {
+		return errors.New("file is not in the export directory")
+	}
+
+	// a link in the export directory may point anywhere, exports are always
+	// regular files
+	info, err := os.Lstat(path)
+	if err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return errors.New("file is a link, not an export")
+	}
+	return nil
+}
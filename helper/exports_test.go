@@ -0,0 +1,188 @@
+package helper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+// writeExport writes data to a file called name in dir, last modified at
+// modTime.
+func writeExport(t *testing.T, dir, name, data string, modTime time.Time) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, []byte(data), exportFilePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chtimes(path, modTime, modTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCheckExportPath(t *testing.T) {
+	dir, cleanup := useTempExportDir(t)
+	defer cleanup()
+	err := CreateDataDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Dir(dir)
+	target := writeExport(t, outside, "dcrseedgen_outside.csv", "secret", time.Now())
+
+	tests := []struct {
+		name  string
+		path  string
+		valid bool
+	}{
+		{"export", filepath.Join(dir, "dcrseedgen_1.csv"), true},
+		{"cleaned path", filepath.Join(dir, "sub", "..", "dcrseedgen_1.csv"), true},
+		{"parent directory", filepath.Join(dir, "..", "dcrseedgen_outside.csv"), false},
+		{"outside", target, false},
+		{"sub directory", filepath.Join(dir, "sub", "dcrseedgen_1.csv"), false},
+		{"not an export", filepath.Join(dir, "notes.txt"), false},
+		{"relative", "dcrseedgen_1.csv", false},
+	}
+
+	if runtime.GOOS != "windows" {
+		err = os.Symlink(target, filepath.Join(dir, "dcrseedgen_link.csv"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tests = append(tests, struct {
+			name  string
+			path  string
+			valid bool
+		}{"link out of the directory", filepath.Join(dir, "dcrseedgen_link.csv"), false})
+	}
+
+	for _, test := range tests {
+		err := checkExportPath(test.path)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got %v, want valid %v", test.name, err, test.valid)
+		}
+	}
+
+	// none of the paths that were rejected can be deleted
+	for _, test := range tests {
+		if !test.valid && SecureDeleteExport(test.path) == nil {
+			t.Errorf("%s: deleted", test.name)
+		}
+	}
+	data, err := ioutil.ReadFile(target)
+	if err != nil || string(data) != "secret" {
+		t.Errorf("file outside the export directory: got %q, %v", data, err)
+	}
+}
+
+func TestSecureDeleteExport(t *testing.T) {
+	dir, cleanup := useTempExportDir(t)
+	defer cleanup()
+	err := CreateDataDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := bytes.Repeat([]byte("secret"), 1000)
+	path := writeExport(t, dir, "dcrseedgen_1.csv", string(original), time.Now())
+
+	defer func(sync func(*os.File) error, remove func(string) error) {
+		syncExport, removeExport = sync, remove
+	}(syncExport, removeExport)
+
+	var steps []string
+	var overwritten []byte
+	syncExport = func(file *os.File) error {
+		steps = append(steps, "sync")
+		overwritten, _ = ioutil.ReadFile(path)
+		return file.Sync()
+	}
+	removeExport = func(name string) error {
+		steps = append(steps, "remove")
+		info, err := os.Stat(name)
+		if err != nil || info.Size() != 0 {
+			t.Errorf("removing: got %v, want an emptied file", err)
+		}
+		return os.Remove(name)
+	}
+
+	err = SecureDeleteExport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"sync", "remove"}; !reflect.DeepEqual(steps, want) {
+		t.Errorf("steps: got %v, want %v", steps, want)
+	}
+	if len(overwritten) != len(original) {
+		t.Errorf("overwritten size: got %d, want %d", len(overwritten), len(original))
+	}
+	if bytes.Contains(overwritten, []byte("secret")) {
+		t.Error("the export was not overwritten before it was removed")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("got %v, want the export removed", err)
+	}
+}
+
+func TestListExports(t *testing.T) {
+	dir, cleanup := useTempExportDir(t)
+	defer cleanup()
+
+	exports, err := ListExports()
+	if err != nil || exports != nil {
+		t.Errorf("no export directory: got %v, %v", exports, err)
+	}
+
+	err = CreateDataDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := strings.Join(seedgen.KeyPairCSVHeader, ",")
+	row := "DsmcYVbP1Nmag2H4AS17UTvmWXmGeA7nLDx,PmQdGRXNZdAgEqwDZMLAF2XSQRLFeSFKi4HLPbdW3kC66HegjYtxq"
+	for i := 2; i < len(seedgen.KeyPairCSVHeader); i++ {
+		row += ",x"
+	}
+	now := time.Now()
+	writeExport(t, dir, "dcrseedgen_old.csv", header+"\n"+row+"\n", now.Add(-2*time.Hour))
+	writeExport(t, dir, "dcrseedgen_new.csv.enc", "ciphertext", now)
+	writeExport(t, dir, "dcrseedgen_middle.csv", row+"\n"+row+"\n", now.Add(-time.Hour))
+	writeExport(t, dir, "notes.txt", "not an export", now)
+	err = os.Mkdir(filepath.Join(dir, "dcrseedgen_dir"), exportDirPerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exports, err = ListExports()
+	if err != nil {
+		t.Fatal(err)
+	}
+	type summary struct {
+		name      string
+		encrypted bool
+		rows      int
+		network   string
+	}
+	want := []summary{
+		{"dcrseedgen_new.csv.enc", true, -1, ""},
+		{"dcrseedgen_middle.csv", false, 2, string(seedgen.Mainnet)},
+		{"dcrseedgen_old.csv", false, 1, string(seedgen.Mainnet)},
+	}
+	got := make([]summary, len(exports))
+	for i, export := range exports {
+		got[i] = summary{export.Name, export.Encrypted, export.Rows, export.Network}
+		if export.Path != filepath.Join(dir, export.Name) {
+			t.Errorf("%s: path %s is not in %s", export.Name, export.Path, dir)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"sync"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+)
+
+var (
+	strictAirGapMu sync.Mutex
+	strictAirGap   bool
+)
+
+// SetStrictAirGap sets whether seeds and keys may only be generated while
+// the machine is offline.
+func SetStrictAirGap(strict bool) {
+	strictAirGapMu.Lock()
+	defer strictAirGapMu.Unlock()
+	strictAirGap = strict
+}
+
+// StrictAirGap reports whether generating is refused while online.
+func StrictAirGap() bool {
+	strictAirGapMu.Lock()
+	defer strictAirGapMu.Unlock()
+	return strictAirGap
+}
+
+// GenerateOptions returns the options the app generates seeds and keys with.
+func GenerateOptions() seedgen.GenerateOptions {
+	return seedgen.GenerateOptions{
+		RequireOffline: StrictAirGap(),
+	}
+}
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"flag"
 	"image"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	app "gioui.org/app"
 	"gioui.org/font/opentype"
@@ -12,12 +14,76 @@ import (
 
 	"github.com/markbates/pkger"
 	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
 	"github.com/raedahgroup/dcrseedgen/ui"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+var (
+	exportDir        = flag.String("exportdir", "", "directory to write exports to (default "+helper.DefaultExportDirectory()+")")
+	clipboardTimeout = flag.Duration("clipboardtimeout", helper.DefaultClipboardTimeout, "how long copied seeds and private keys stay on the clipboard")
+	strictAirGap     = flag.Bool("strictairgap", false, "refuse to generate seeds and private keys while the machine is online")
+	lockMemory       = flag.Bool("mlock", false, "lock seeds and private keys into memory so they are never swapped to disk (linux only)")
 )
 
 func main() {
+	// seeds and private keys must not end up in a core dump
+	err := helper.DisableCoreDumps()
+	if err != nil {
+		log.Fatalf("error disabling core dumps: %s", err.Error())
+	}
+
+	flag.Parse()
+	seedgen.SetMemoryLocking(*lockMemory)
+	// every secret left is wiped when the window closes
+	seedgen.SetSecretTracking(true)
+	helper.SetStrictAirGap(*strictAirGap)
+	if *clipboardTimeout <= 0 {
+		log.Fatal("clipboardtimeout must be positive")
+	}
+
+	var themes, languages []string
+	for _, palette := range theme.Palettes {
+		themes = append(themes, palette.Name)
+	}
+	for _, locale := range i18n.Locales {
+		languages = append(languages, locale.Tag)
+	}
+	helper.SetConfigChoices(themes, languages)
+
+	// settings that can't be used are replaced by their defaults, so carry on
+	cfg, err := helper.LoadConfig()
+	if err != nil {
+		log.Printf("error loading settings: %s", err.Error())
+	}
+
+	// command line flags take precedence over the saved settings
+	flagsSet := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		flagsSet[f.Name] = true
+	})
+	if flagsSet["exportdir"] {
+		helper.SetExportDirectory(*exportDir)
+	} else {
+		helper.SetExportDirectory(cfg.ExportDirectory)
+	}
+	timeout := time.Duration(cfg.ClipboardTimeout)
+	if flagsSet["clipboardtimeout"] {
+		timeout = *clipboardTimeout
+	}
+
+	// run a command line subcommand instead of the gui if one was given
+	if flag.NArg() > 0 {
+		err := runCommand(flag.Arg(0), flag.Args()[1:])
+		if err != nil {
+			log.Fatalf("error running %s: %s", flag.Arg(0), err.Error())
+		}
+		return
+	}
+
 	// make data directory if not exists
-	err := helper.CreateDataDirectory()
+	err = helper.CreateDataDirectory()
 	if err != nil {
 		log.Fatalf("error creating data directory: %s", err.Error())
 	}
@@ -34,7 +100,7 @@ func main() {
 		log.Fatalf("error loading decred icons: %s", err.Error())
 	}
 
-	win := ui.NewWindow(decredIcons, col)
+	win := ui.NewWindow(decredIcons, col, cfg, timeout)
 	go win.Loop()
 
 	app.Main()
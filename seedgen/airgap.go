@@ -0,0 +1,65 @@
+package seedgen
+
+import (
+	"net"
+	"strings"
+)
+
+// ConnectivityStatus lists the network connections that make a machine unfit
+// for generating long-term secrets.
+type ConnectivityStatus struct {
+	// Interfaces are the non-loopback interfaces that are up.
+	Interfaces []string
+	// DefaultRoutes are the default routes, e.g. "eth0 via 192.168.1.1".
+	// They are only checked on Linux.
+	DefaultRoutes []string
+}
+
+// Online reports whether the machine has any network connection.
+func (status *ConnectivityStatus) Online() bool {
+	return len(status.Interfaces) > 0 || len(status.DefaultRoutes) > 0
+}
+
+// String describes the connections found.
+func (status *ConnectivityStatus) String() string {
+	var parts []string
+	if len(status.Interfaces) > 0 {
+		parts = append(parts, "interfaces up: "+strings.Join(status.Interfaces, ", "))
+	}
+	if len(status.DefaultRoutes) > 0 {
+		parts = append(parts, "default routes: "+strings.Join(status.DefaultRoutes, ", "))
+	}
+	if len(parts) == 0 {
+		return "offline"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// netInterfaces lists the network interfaces, tests replace it to make the
+// check fail.
+var netInterfaces = net.Interfaces
+
+// CheckConnectivity returns the network connections of the machine. It
+// fails with ErrConnectivityCheck if they can't be read, which must not be
+// taken to mean that the machine is offline.
+func CheckConnectivity() (*ConnectivityStatus, error) {
+	const op = "seedgen.CheckConnectivity"
+
+	interfaces, err := netInterfaces()
+	if err != nil {
+		return nil, newError(op, ErrConnectivityCheck, err)
+	}
+
+	status := new(ConnectivityStatus)
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagUp != 0 && iface.Flags&net.FlagLoopback == 0 {
+			status.Interfaces = append(status.Interfaces, iface.Name)
+		}
+	}
+
+	status.DefaultRoutes, err = defaultRoutes()
+	if err != nil {
+		return nil, newError(op, ErrConnectivityCheck, err)
+	}
+	return status, nil
+}
@@ -0,0 +1,98 @@
+package seedgen
+
+import (
+	"bufio"
+	"encoding/hex"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	routeFlagUp     = 0x0001
+	routeFlagReject = 0x0200
+)
+
+// defaultRoutes reads the IPv4 and IPv6 default routes from /proc.
+func defaultRoutes() ([]string, error) {
+	routes, err := readRoutes("/proc/net/route", parseIPv4Route)
+	if err != nil {
+		return nil, err
+	}
+
+	ipv6Routes, err := readRoutes("/proc/net/ipv6_route", parseIPv6Route)
+	if err != nil {
+		return nil, err
+	}
+	return append(routes, ipv6Routes...), nil
+}
+
+// readRoutes returns the default routes picked out by parse from the lines
+// of a route table. A missing table, e.g. with IPv6 disabled, has none.
+func readRoutes(path string, parse func(fields []string) (string, bool)) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var routes []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if route, ok := parse(strings.Fields(scanner.Text())); ok {
+			routes = append(routes, route)
+		}
+	}
+	return routes, scanner.Err()
+}
+
+// parseIPv4Route parses a line of /proc/net/route:
+// Iface Destination Gateway Flags RefCnt Use Metric Mask ...
+func parseIPv4Route(fields []string) (string, bool) {
+	if len(fields) < 8 || fields[1] != "00000000" || fields[7] != "00000000" {
+		return "", false
+	}
+	if !routeUsable(fields[3], fields[0]) {
+		return "", false
+	}
+
+	// the gateway is in host byte order, which is little endian on every
+	// platform this runs on
+	gateway, err := hex.DecodeString(fields[2])
+	if err != nil || len(gateway) != net.IPv4len {
+		return fields[0], true
+	}
+	ip := net.IPv4(gateway[3], gateway[2], gateway[1], gateway[0])
+	return fields[0] + " via " + ip.String(), true
+}
+
+// parseIPv6Route parses a line of /proc/net/ipv6_route:
+// Destination PrefixLen Source SourcePrefixLen NextHop Metric RefCnt Use Flags Iface
+func parseIPv6Route(fields []string) (string, bool) {
+	if len(fields) < 10 || strings.Trim(fields[0], "0") != "" || fields[1] != "00" {
+		return "", false
+	}
+	if !routeUsable(fields[8], fields[9]) {
+		return "", false
+	}
+
+	nextHop, err := hex.DecodeString(fields[4])
+	if err != nil || len(nextHop) != net.IPv6len || net.IP(nextHop).IsUnspecified() {
+		return fields[9], true
+	}
+	return fields[9] + " via " + net.IP(nextHop).String(), true
+}
+
+// routeUsable reports whether a route with the given hex flags on iface can
+// carry traffic off the machine.
+func routeUsable(flagsHex, iface string) bool {
+	flags, err := strconv.ParseUint(flagsHex, 16, 32)
+	if err != nil {
+		return false
+	}
+	return iface != "lo" && flags&routeFlagUp != 0 && flags&routeFlagReject == 0
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+// +build !linux
+
+package seedgen
+
+// defaultRoutes is only implemented on Linux, elsewhere the interfaces alone
+// decide whether the machine is online.
+func defaultRoutes() ([]string, error) {
+	return nil, nil
+}
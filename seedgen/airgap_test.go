@@ -0,0 +1,69 @@
+package seedgen
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+)
+
+// withInterfaces makes CheckConnectivity see interfaces and err until the
+// returned function is called.
+func withInterfaces(interfaces []net.Interface, err error) func() {
+	netInterfaces = func() ([]net.Interface, error) {
+		return interfaces, err
+	}
+	return func() {
+		netInterfaces = net.Interfaces
+	}
+}
+
+func TestCheckConnectivityFailure(t *testing.T) {
+	defer withInterfaces(nil, errors.New("netlink unavailable"))()
+
+	_, err := CheckConnectivity()
+	if !errors.Is(err, ErrConnectivityCheck) {
+		t.Errorf("got %v, want ErrConnectivityCheck", err)
+	}
+	if errors.Is(err, ErrOnline) {
+		t.Error("a failed check is reported as the machine being online")
+	}
+
+	_, err = GenerateSeed(DefaultSeedSize, GenerateOptions{Entropy: bytes.NewReader(make([]byte, 32)), RequireOffline: true})
+	if !errors.Is(err, ErrConnectivityCheck) || errors.Is(err, ErrOnline) {
+		t.Errorf("generating: got %v, want ErrConnectivityCheck", err)
+	}
+}
+
+func TestRequireOfflineOnline(t *testing.T) {
+	defer withInterfaces([]net.Interface{{Name: "eth0", Flags: net.FlagUp}}, nil)()
+
+	_, err := GenerateKeyPair(Mainnet, GenerateOptions{Entropy: bytes.NewReader(privateKeyOne), RequireOffline: true})
+	if !errors.Is(err, ErrOnline) {
+		t.Errorf("got %v, want ErrOnline", err)
+	}
+	if errors.Is(err, ErrConnectivityCheck) {
+		t.Error("a machine found online is reported as a failed check")
+	}
+}
+
+func TestConnectivityStatus(t *testing.T) {
+	tests := []struct {
+		status ConnectivityStatus
+		online bool
+		text   string
+	}{
+		{ConnectivityStatus{}, false, "offline"},
+		{ConnectivityStatus{Interfaces: []string{"eth0", "wlan0"}}, true, "interfaces up: eth0, wlan0"},
+		{ConnectivityStatus{Interfaces: []string{"eth0"}, DefaultRoutes: []string{"eth0 via 192.168.1.1"}}, true,
+			"interfaces up: eth0; default routes: eth0 via 192.168.1.1"},
+	}
+	for _, test := range tests {
+		if online := test.status.Online(); online != test.online {
+			t.Errorf("%+v: online: got %v, want %v", test.status, online, test.online)
+		}
+		if text := test.status.String(); text != test.text {
+			t.Errorf("%+v: got %q, want %q", test.status, text, test.text)
+		}
+	}
+}
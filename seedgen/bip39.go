@@ -0,0 +1,353 @@
+package seedgen
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// bip39Iterations is the PBKDF2 iteration count BIP39 derives the
+	// wallet seed with.
+	bip39Iterations = 2048
+
+	// bip44Purpose is the first level of BIP44 derivation paths.
+	bip44Purpose = 44
+)
+
+// MnemonicWordCounts lists the lengths a BIP39 mnemonic can be generated
+// with.
+var MnemonicWordCounts = []int{12, 18, 24}
+
+var (
+	bip39WordsOnce   sync.Once
+	bip39Words       []string
+	bip39WordIndexes map[string]int
+)
+
+func loadBIP39Words() {
+	bip39WordsOnce.Do(func() {
+		bip39Words = strings.Fields(bip39EnglishWords)
+		bip39WordIndexes = make(map[string]int, len(bip39Words))
+		for i, word := range bip39Words {
+			bip39WordIndexes[word] = i
+		}
+	})
+}
+
+// Mnemonic is a BIP39 mnemonic as used by hardware wallets. It is NOT a
+// dcrwallet seed: its words come from the BIP39 word list, dcrwallet can't
+// restore it, and the wallet seed is derived from the words and an optional
+// passphrase rather than being the random bytes themselves.
+type Mnemonic struct {
+	entropy *Secret
+}
+
+// GenerateMnemonic returns a new random BIP39 mnemonic of wordCount words,
+// one of MnemonicWordCounts.
+func GenerateMnemonic(wordCount int, opts GenerateOptions) (*Mnemonic, error) {
+	const op = "seedgen.GenerateMnemonic"
+
+	err := opts.checkOffline(op)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validMnemonicWordCount(wordCount) {
+		return nil, errorf(op, ErrInvalidSeedSize, fmt.Sprintf("%d words, want one of %v", wordCount, MnemonicWordCounts))
+	}
+
+	// every word encodes 11 bits, one bit in 33 is checksum
+	entropy := make([]byte, wordCount*11*32/33/8)
+	_, err = io.ReadFull(opts.entropy(), entropy)
+	if err != nil {
+		zero(entropy)
+		return nil, newError(op, ErrEntropy, err)
+	}
+
+	return &Mnemonic{entropy: NewSecret(entropy)}, nil
+}
+
+// ParseMnemonic decodes and checks the words of a BIP39 mnemonic.
+func ParseMnemonic(words []string) (*Mnemonic, error) {
+	const op = "seedgen.ParseMnemonic"
+
+	if !validMnemonicWordCount(len(words)) {
+		return nil, errorf(op, ErrInvalidMnemonic, fmt.Sprintf("%d words, want one of %v", len(words), MnemonicWordCounts))
+	}
+
+	loadBIP39Words()
+	bits := make([]byte, len(words)*11)
+	defer zero(bits)
+	for i, word := range words {
+		index, ok := bip39WordIndexes[strings.ToLower(word)]
+		if !ok {
+			return nil, errorf(op, ErrInvalidMnemonic, fmt.Sprintf("%q is not a BIP39 word", word))
+		}
+		for bit := 0; bit < 11; bit++ {
+			bits[i*11+bit] = byte(index>>(10-bit)) & 1
+		}
+	}
+
+	entropy := make([]byte, len(bits)*32/33/8)
+	for i := range entropy {
+		for bit := 0; bit < 8; bit++ {
+			entropy[i] = entropy[i]<<1 | bits[i*8+bit]
+		}
+	}
+	checksum := mnemonicBits(entropy)[len(entropy)*8:]
+	for i, bit := range checksum {
+		if bits[len(entropy)*8+i] != bit {
+			zero(entropy)
+			return nil, errorf(op, ErrInvalidMnemonic, "checksum mismatch")
+		}
+	}
+
+	return &Mnemonic{entropy: NewSecret(entropy)}, nil
+}
+
+func validMnemonicWordCount(count int) bool {
+	for _, valid := range MnemonicWordCounts {
+		if count == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// mnemonicBits returns the bits of entropy followed by its checksum, one
+// bit per byte.
+func mnemonicBits(entropy []byte) []byte {
+	hash := sha256.Sum256(entropy)
+	checksumBits := len(entropy) * 8 / 32
+
+	bits := make([]byte, 0, len(entropy)*8+checksumBits)
+	for _, b := range entropy {
+		for bit := 7; bit >= 0; bit-- {
+			bits = append(bits, b>>uint(bit)&1)
+		}
+	}
+	for i := 0; i < checksumBits; i++ {
+		bits = append(bits, hash[i/8]>>uint(7-i%8)&1)
+	}
+	return bits
+}
+
+// Words returns the mnemonic words.
+func (mnemonic *Mnemonic) Words() []string {
+	loadBIP39Words()
+	bits := mnemonicBits(mnemonic.entropy.Bytes())
+	defer zero(bits)
+
+	words := make([]string, len(bits)/11)
+	for i := range words {
+		index := 0
+		for _, bit := range bits[i*11 : i*11+11] {
+			index = index<<1 | int(bit)
+		}
+		words[i] = bip39Words[index]
+	}
+	return words
+}
+
+// Word returns the mnemonic word at index. Unlike Words it leaves the other
+// words alone, e.g. for drawing one revealed word.
+func (mnemonic *Mnemonic) Word(index int) string {
+	loadBIP39Words()
+	entropy := mnemonic.entropy.Bytes()
+	entropyBits := len(entropy) * 8
+	if index < 0 || index >= (entropyBits+entropyBits/32)/11 {
+		panic("seedgen: mnemonic word index out of range")
+	}
+
+	// only the last word has checksum bits
+	var hash [sha256.Size]byte
+	if (index+1)*11 > entropyBits {
+		hash = sha256.Sum256(entropy)
+		defer zero(hash[:])
+	}
+
+	wordIndex := 0
+	for bit := index * 11; bit < index*11+11; bit++ {
+		var b byte
+		if bit < entropyBits {
+			b = entropy[bit/8] >> uint(7-bit%8) & 1
+		} else {
+			checksumBit := bit - entropyBits
+			b = hash[checksumBit/8] >> uint(7-checksumBit%8) & 1
+		}
+		wordIndex = wordIndex<<1 | int(b)
+	}
+	return bip39Words[wordIndex]
+}
+
+// Seed returns the 64 byte BIP32 seed of the mnemonic with passphrase, which
+// may be empty. Every passphrase gives a different, valid seed.
+func (mnemonic *Mnemonic) Seed(passphrase string) *Secret {
+	sentence := []byte(strings.Join(mnemonic.Words(), " "))
+	salt := []byte("mnemonic" + norm.NFKD.String(passphrase))
+	seed := pbkdf2SHA512(sentence, salt, bip39Iterations, sha512.Size)
+	zero(sentence)
+	zero(salt)
+	return NewSecret(seed)
+}
+
+// Wipe zeroes the mnemonic.
+func (mnemonic *Mnemonic) Wipe() {
+	mnemonic.entropy.Wipe()
+}
+
+// Account holds the BIP44 keys of a Decred account derived from a BIP39
+// mnemonic, as a hardware wallet would show them.
+type Account struct {
+	// Path is the derivation path of the account, e.g. m/44'/42'/0'.
+	Path    string
+	Network Network
+	// ExtendedPublicKey is the account public key (dpub/tpub/...), it lets
+	// a watching-only wallet follow the account.
+	ExtendedPublicKey string
+	// FirstAddress is the first receiving address of the account, at
+	// Path/0/0, for checking against the hardware wallet.
+	FirstAddress string
+
+	extendedPrivateKey *Secret
+}
+
+// ExtendedPrivateKey returns the account private key (dprv/tprv/...).
+func (account *Account) ExtendedPrivateKey() string {
+	return string(account.extendedPrivateKey.Bytes())
+}
+
+// Wipe zeroes the account private key.
+func (account *Account) Wipe() {
+	account.extendedPrivateKey.Wipe()
+}
+
+// Account derives the keys of BIP44 account index for network from the
+// mnemonic with passphrase. Mainnet uses the Decred coin type 42, the test
+// networks the coin type 1 shared by all testnets.
+func (mnemonic *Mnemonic) Account(network Network, index uint32, passphrase string) (*Account, error) {
+	const op = "seedgen.Mnemonic.Account"
+
+	if !network.valid() {
+		return nil, errorf(op, ErrUnknownNetwork, string(network))
+	}
+	if index >= hdkeychain.HardenedKeyStart {
+		return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("account %d is out of range", index))
+	}
+
+	params := network.Params()
+	seed := mnemonic.Seed(passphrase)
+	defer seed.Wipe()
+
+	key, err := hdkeychain.NewMaster(seed.Bytes(), params)
+	if err != nil {
+		return nil, newError(op, ErrInvalidSeedSize, err)
+	}
+	path := []uint32{bip44Purpose, params.SLIP0044CoinType, index}
+	for _, child := range path {
+		next, err := hardenedChild(key, child+hdkeychain.HardenedKeyStart, params)
+		key.Zero()
+		if err != nil {
+			return nil, newError(op, ErrInvalidPrivateKey, err)
+		}
+		key = next
+	}
+	defer key.Zero()
+
+	public, err := key.Neuter()
+	if err != nil {
+		return nil, newError(op, ErrInvalidPrivateKey, err)
+	}
+	address, err := firstAddress(public, network)
+	if err != nil {
+		return nil, newError(op, ErrInvalidAddress, err)
+	}
+
+	return &Account{
+		Path:               fmt.Sprintf("m/%d'/%d'/%d'", bip44Purpose, params.SLIP0044CoinType, index),
+		Network:            network,
+		ExtendedPublicKey:  public.String(),
+		FirstAddress:       address,
+		extendedPrivateKey: NewSecret([]byte(key.String())),
+	}, nil
+}
+
+// hardenedChild derives a hardened child of key the way BIP32 does.
+// hdkeychain drops leading zero bytes of private keys, which derives a
+// different hardened child than other wallets for about one key in 256, so
+// the child is re-parsed from its serialization, which keeps all 32 bytes.
+func hardenedChild(key *hdkeychain.ExtendedKey, index uint32, params hdkeychain.NetworkParams) (*hdkeychain.ExtendedKey, error) {
+	padded, err := hdkeychain.NewKeyFromString(key.String(), params)
+	if err != nil {
+		return nil, err
+	}
+	defer padded.Zero()
+	return padded.Child(index)
+}
+
+// firstAddress returns the address at 0/0 below account.
+func firstAddress(account *hdkeychain.ExtendedKey, network Network) (string, error) {
+	external, err := account.Child(0)
+	if err != nil {
+		return "", err
+	}
+	child, err := external.Child(0)
+	if err != nil {
+		return "", err
+	}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	addr, err := dcrutil.NewAddressPubKeyHash(
+		dcrutil.Hash160(pubKey.SerializeCompressed()),
+		network.Params(),
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		return "", err
+	}
+	return addr.Address(), nil
+}
+
+// pbkdf2SHA512 is PBKDF2 (RFC 8018) with HMAC-SHA512.
+func pbkdf2SHA512(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha512.New, password)
+	key := make([]byte, 0, keyLen+prf.Size())
+	u := make([]byte, prf.Size())
+	block := make([]byte, prf.Size())
+	var counter [4]byte
+
+	for i := uint32(1); len(key) < keyLen; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write(counter[:])
+		u = prf.Sum(u[:0])
+		copy(block, u)
+
+		for n := 1; n < iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range block {
+				block[j] ^= u[j]
+			}
+		}
+		key = append(key, block...)
+	}
+	zero(u)
+	zero(block)
+	zero(key[keyLen:cap(key)])
+	return key[:keyLen]
+}
@@ -0,0 +1,298 @@
+package seedgen
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+)
+
+// bip39Vectors are from the reference test vectors of BIP39, all with the
+// passphrase "TREZOR".
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		entropy:  "808080808080808080808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always",
+		seed:     "107d7c02a5aa6f38c58083ff74f04c607c2d2c0ecc55501dadd72d025b751bc27fe913ffb796f841c49b1d33b610cf0e91d3aa239027f5e99fe4ce9e5088cd65",
+	},
+	{
+		entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		seed:     "dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+	},
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		entropy, _ := hex.DecodeString(vector.entropy)
+		wordCount := len(strings.Fields(vector.mnemonic))
+
+		mnemonic, err := GenerateMnemonic(wordCount, GenerateOptions{Entropy: bytes.NewReader(entropy)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(mnemonic.Words(), " "); got != vector.mnemonic {
+			t.Errorf("mnemonic: got %s, want %s", got, vector.mnemonic)
+		}
+		if got := mnemonic.Seed("TREZOR").Hex(); got != vector.seed {
+			t.Errorf("%s: seed: got %s, want %s", vector.entropy, got, vector.seed)
+		}
+	}
+}
+
+func TestParseMnemonic(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		mnemonic, err := ParseMnemonic(strings.Fields(vector.mnemonic))
+		if err != nil {
+			t.Fatalf("%s: %v", vector.mnemonic, err)
+		}
+		if got := mnemonic.entropy.Hex(); got != vector.entropy {
+			t.Errorf("entropy: got %s, want %s", got, vector.entropy)
+		}
+	}
+
+	invalid := []string{
+		"abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon aardvark",
+	}
+	for _, words := range invalid {
+		_, err := ParseMnemonic(strings.Fields(words))
+		if !errors.Is(err, ErrInvalidMnemonic) {
+			t.Errorf("%s: got %v, want ErrInvalidMnemonic", words, err)
+		}
+	}
+}
+
+func TestGenerateMnemonicInvalidWordCount(t *testing.T) {
+	for _, count := range []int{0, 11, 15, 33} {
+		_, err := GenerateMnemonic(count, GenerateOptions{Entropy: bytes.NewReader(make([]byte, 64))})
+		if !errors.Is(err, ErrInvalidSeedSize) {
+			t.Errorf("%d words: got %v, want ErrInvalidSeedSize", count, err)
+		}
+	}
+}
+
+// The account keys of the first BIP39 vector without a passphrase, checked
+// against bip32Account.
+const (
+	abandonMainnetXpub    = "dpubZEnF9ruuqJ6x3BodZBH29f5fvMTWzHn6rv1QutMamMoEsogNFa7YVmczW3iaiop6Q2Cj21uvekYGFJvuML7V871Htp8RGgj8ZMi7dMVPT4T"
+	abandonMainnetAddress = "Dso5BhFYjnymYwAzsCDEUENmmh7Y9TA4FM7"
+	abandonTestnetXpub    = "tpubVp4NK6Xb2oYX5arDoTZws8NCbdYEhyzGsDLMgB71MyYcBBHsz9yrs6k6cb1aTNFdf9HUCDUzCp8HhCrjLUUC6FUV66EPxcWBojm8U8gcnn2"
+	abandonTestnetAddress = "TsRNPKrLuu6oKMWYoXyjeaZUp5isnb9SEq9"
+)
+
+func TestMnemonicAccount(t *testing.T) {
+	mnemonic, err := ParseMnemonic(strings.Fields(bip39Vectors[0].mnemonic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		network    Network
+		path       string
+		privPrefix string
+		xpub       string
+		address    string
+	}{
+		{Mainnet, "m/44'/42'/0'", "dprv", abandonMainnetXpub, abandonMainnetAddress},
+		{Testnet3, "m/44'/1'/0'", "tprv", abandonTestnetXpub, abandonTestnetAddress},
+	}
+	for _, test := range tests {
+		account, err := mnemonic.Account(test.network, 0, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if account.Path != test.path {
+			t.Errorf("%s: path: got %s, want %s", test.network, account.Path, test.path)
+		}
+		if !strings.HasPrefix(account.ExtendedPrivateKey(), test.privPrefix) {
+			t.Errorf("%s: private key %s does not start with %s", test.network, account.ExtendedPrivateKey(), test.privPrefix)
+		}
+		if account.ExtendedPublicKey != test.xpub {
+			t.Errorf("%s: public key: got %s, want %s", test.network, account.ExtendedPublicKey, test.xpub)
+		}
+		if account.FirstAddress != test.address {
+			t.Errorf("%s: address: got %s, want %s", test.network, account.FirstAddress, test.address)
+		}
+
+		seed := mnemonic.Seed("")
+		xpub, address, _ := bip32Account(seed.Bytes(), test.network, accountPath(test.network))
+		seed.Wipe()
+		if xpub != test.xpub || address != test.address {
+			t.Errorf("%s: reference derivation: got %s %s, want %s %s", test.network, xpub, address, test.xpub, test.address)
+		}
+	}
+
+	plain, _ := mnemonic.Account(Mainnet, 0, "")
+	withPassphrase, _ := mnemonic.Account(Mainnet, 0, "TREZOR")
+	if plain.ExtendedPublicKey == withPassphrase.ExtendedPublicKey {
+		t.Error("the passphrase did not change the account keys")
+	}
+}
+
+// TestMnemonicAccountLeadingZero derives an account whose path goes through a
+// private key with a leading zero byte, which hdkeychain on its own derives
+// differently from BIP32.
+func TestMnemonicAccountLeadingZero(t *testing.T) {
+	entropy, _ := hex.DecodeString("0000000000000000000000000000000b")
+	mnemonic, err := GenerateMnemonic(12, GenerateOptions{Entropy: bytes.NewReader(entropy)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seed := mnemonic.Seed("")
+	xpub, address, leadingZero := bip32Account(seed.Bytes(), Mainnet, accountPath(Mainnet))
+	seed.Wipe()
+	if !leadingZero {
+		t.Fatal("no private key on the path has a leading zero byte")
+	}
+
+	account, err := mnemonic.Account(Mainnet, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.ExtendedPublicKey != xpub {
+		t.Errorf("public key: got %s, want %s", account.ExtendedPublicKey, xpub)
+	}
+	if account.FirstAddress != address {
+		t.Errorf("address: got %s, want %s", account.FirstAddress, address)
+	}
+}
+
+// accountPath returns the hardened BIP44 path of account 0 on network.
+func accountPath(network Network) []uint32 {
+	return []uint32{
+		bip44Purpose + hdkeychain.HardenedKeyStart,
+		network.Params().SLIP0044CoinType + hdkeychain.HardenedKeyStart,
+		hdkeychain.HardenedKeyStart,
+	}
+}
+
+func TestMnemonicWord(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		mnemonic, err := ParseMnemonic(strings.Fields(vector.mnemonic))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, word := range strings.Fields(vector.mnemonic) {
+			if got := mnemonic.Word(i); got != word {
+				t.Errorf("%s: word %d: got %s, want %s", vector.entropy, i, got, word)
+			}
+		}
+	}
+}
+
+// bip32Account derives the account public key and first address at path
+// from seed the way BIP32 and BIP44 describe, without hdkeychain, so that
+// Account is checked against a second implementation. leadingZero reports
+// whether one of the private keys on the path starts with a zero byte.
+func bip32Account(seed []byte, network Network, path []uint32) (xpub, address string, leadingZero bool) {
+	curve := secp256k1.S256()
+	params := network.Params()
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+
+	pubKey := func(key *big.Int) []byte {
+		x, y := curve.ScalarBaseMult(ser256(key))
+		return (&secp256k1.PublicKey{Curve: curve, X: x, Y: y}).SerializeCompressed()
+	}
+	child := func(key *big.Int, chainCode []byte, index uint32) (*big.Int, []byte) {
+		mac := hmac.New(sha512.New, chainCode)
+		if index >= hdkeychain.HardenedKeyStart {
+			mac.Write([]byte{0})
+			mac.Write(ser256(key))
+		} else {
+			mac.Write(pubKey(key))
+		}
+		var i [4]byte
+		binary.BigEndian.PutUint32(i[:], index)
+		mac.Write(i[:])
+		sum := mac.Sum(nil)
+		childKey := new(big.Int).SetBytes(sum[:32])
+		childKey.Add(childKey, key)
+		childKey.Mod(childKey, curve.N)
+		return childKey, sum[32:]
+	}
+
+	var parentFP []byte
+	for _, index := range path {
+		leadingZero = leadingZero || ser256(key)[0] == 0
+		parentFP = dcrutil.Hash160(pubKey(key))[:4]
+		key, chainCode = child(key, chainCode, index)
+	}
+	leadingZero = leadingZero || ser256(key)[0] == 0
+
+	serialized := append([]byte{}, params.HDPublicKeyID[:]...)
+	serialized = append(serialized, byte(len(path)))
+	serialized = append(serialized, parentFP...)
+	var childNum [4]byte
+	binary.BigEndian.PutUint32(childNum[:], path[len(path)-1])
+	serialized = append(serialized, childNum[:]...)
+	serialized = append(serialized, chainCode...)
+	serialized = append(serialized, pubKey(key)...)
+	xpub = base58Check(serialized)
+
+	external, externalChainCode := child(key, chainCode, 0)
+	first, _ := child(external, externalChainCode, 0)
+	address = base58Check(append(params.PubKeyHashAddrID[:], dcrutil.Hash160(pubKey(first))...))
+	return xpub, address, leadingZero
+}
+
+// ser256 returns n as 32 big endian bytes.
+func ser256(n *big.Int) []byte {
+	b := n.Bytes()
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+// base58Check encodes data with the four byte BLAKE-256d checksum Decred
+// uses for keys and addresses.
+func base58Check(data []byte) string {
+	const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	data = append(data, chainhash.HashB(chainhash.HashB(data))[:4]...)
+
+	var encoded []byte
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+	for n.Sign() > 0 {
+		n.DivMod(n, big.NewInt(58), mod)
+		encoded = append(encoded, alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
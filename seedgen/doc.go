@@ -0,0 +1,26 @@
+// Package seedgen generates Decred wallet seeds, BIP39 mnemonics for hardware
+// wallets and standalone key pairs, and works with the keys it generates:
+// exporting them, signing messages and transactions with them and inspecting
+// them. It has no GUI dependencies.
+//
+// Seeds and private keys are held in Secret buffers that can be wiped, and
+// only turned into strings when asked for. Wipe them once done with them,
+// the package keeps no reference to them unless SetSecretTracking is on.
+//
+//	seed, err := seedgen.GenerateSeed(seedgen.DefaultSeedSize, seedgen.GenerateOptions{})
+//	if err != nil {
+//		return err
+//	}
+//	defer seed.Wipe()
+//	fmt.Println(strings.Join(seed.Words(), " "))
+//
+//	pair, err := seedgen.GenerateKeyPair(seedgen.Mainnet, seedgen.GenerateOptions{})
+//	if err != nil {
+//		return err
+//	}
+//	defer pair.Wipe()
+//	fmt.Println(pair.Address, pair.WIF())
+//
+// Every error returned by the package is an *Error whose Kind can be checked
+// with errors.Is, e.g. errors.Is(err, seedgen.ErrUnknownNetwork).
+package seedgen
@@ -0,0 +1,64 @@
+package seedgen
+
+import (
+	"errors"
+)
+
+// Error kinds, use errors.Is to check which one an error is.
+//
+// ErrOnline means the machine was found to have a network connection, while
+// ErrConnectivityCheck means it could not be told whether it has one. Both
+// refuse generating with GenerateOptions.RequireOffline, but only ErrOnline
+// says anything about the machine.
+var (
+	ErrInvalidSeedSize   = errors.New("invalid seed size")
+	ErrInvalidMnemonic   = errors.New("invalid mnemonic")
+	ErrEntropy           = errors.New("entropy source failed")
+	ErrUnknownNetwork    = errors.New("unknown network")
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	ErrInvalidPublicKey  = errors.New("invalid public key")
+	ErrInvalidAddress    = errors.New("invalid address")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrInvalidTx         = errors.New("invalid transaction")
+	ErrInvalidInput      = errors.New("invalid input")
+	ErrExport            = errors.New("export failed")
+	ErrDecrypt           = errors.New("decryption failed")
+	ErrOnline            = errors.New("machine is online")
+	ErrConnectivityCheck = errors.New("connectivity check failed")
+)
+
+// Error is the type of every error returned by the package.
+type Error struct {
+	// Op is the function that failed, e.g. "seedgen.GenerateSeed".
+	Op string
+	// Kind is one of the Err values above.
+	Kind error
+	// Err is the underlying error, it may be nil.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Op + ": " + e.Kind.Error()
+	}
+	return e.Op + ": " + e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the kind of the error.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func newError(op string, kind error, err error) error {
+	return &Error{Op: op, Kind: kind, Err: err}
+}
+
+// errorf returns an error with a plain message as the underlying error.
+func errorf(op string, kind error, message string) error {
+	return &Error{Op: op, Kind: kind, Err: errors.New(message)}
+}
@@ -0,0 +1,162 @@
+package seedgen
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+)
+
+// encryptedFileMagic prefixes every encrypted export so that it can be told
+// apart from a plaintext file before attempting to decrypt it.
+var encryptedFileMagic = []byte("DCRSEEDGEN-ECIES-1\n")
+
+// Exporter writes rows of an export, e.g. KeyPairCSVHeader followed by the
+// CSVRecord of every key pair.
+type Exporter interface {
+	// Export writes rows to w.
+	Export(w io.Writer, rows [][]string) error
+	// Extension is the file extension exports should be saved with.
+	Extension() string
+}
+
+// CSVExporter exports plaintext CSV.
+type CSVExporter struct{}
+
+// EncryptedCSVExporter exports CSV encrypted to RecipientKey, a hex encoded
+// secp256k1 public key. Only the holder of the matching private key can
+// read it back with DecryptExport.
+type EncryptedCSVExporter struct {
+	RecipientKey string
+}
+
+func (CSVExporter) Export(w io.Writer, rows [][]string) error {
+	err := csv.NewWriter(w).WriteAll(rows)
+	if err != nil {
+		return newError("seedgen.CSVExporter.Export", ErrExport, err)
+	}
+	return nil
+}
+
+func (CSVExporter) Extension() string {
+	return ".csv"
+}
+
+func (exporter EncryptedCSVExporter) Export(w io.Writer, rows [][]string) error {
+	const op = "seedgen.EncryptedCSVExporter.Export"
+
+	var buf bytes.Buffer
+	err := csv.NewWriter(&buf).WriteAll(rows)
+	if err != nil {
+		return newError(op, ErrExport, err)
+	}
+
+	encrypted, err := EncryptForPublicKey(exporter.RecipientKey, buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(encrypted)
+	if err != nil {
+		return newError(op, ErrExport, err)
+	}
+	return nil
+}
+
+func (EncryptedCSVExporter) Extension() string {
+	return ".csv.enc"
+}
+
+// ExportKeyPairs writes pairs with a header row using exporter.
+func ExportKeyPairs(w io.Writer, exporter Exporter, pairs []*KeyPair) error {
+	rows := make([][]string, 0, len(pairs)+1)
+	rows = append(rows, KeyPairCSVHeader)
+	for _, pair := range pairs {
+		rows = append(rows, pair.CSVRecord())
+	}
+	return exporter.Export(w, rows)
+}
+
+// DecryptExport reads an export written by EncryptedCSVExporter with the
+// WIF of the recipient and returns its rows.
+func DecryptExport(r io.Reader, wif string) ([][]string, error) {
+	const op = "seedgen.DecryptExport"
+
+	encrypted, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, newError(op, ErrDecrypt, err)
+	}
+
+	decrypted, err := DecryptWithWIF(wif, encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(decrypted)).ReadAll()
+	if err != nil {
+		return nil, newError(op, ErrDecrypt, err)
+	}
+	return rows, nil
+}
+
+// ParsePublicKey parses a hex encoded compressed or uncompressed secp256k1
+// public key.
+func ParsePublicKey(pubKeyHex string) (*secp256k1.PublicKey, error) {
+	const op = "seedgen.ParsePublicKey"
+
+	pubKeyBytes, err := hex.DecodeString(strings.TrimSpace(pubKeyHex))
+	if err != nil {
+		return nil, errorf(op, ErrInvalidPublicKey, "public key is not valid hex")
+	}
+	pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, newError(op, ErrInvalidPublicKey, err)
+	}
+	return pubKey, nil
+}
+
+// EncryptForPublicKey encrypts data to the holder of the private key of the
+// hex encoded public key. The ciphertext uses secp256k1 ECIES with
+// AES-256-CBC and HMAC-SHA-256 as implemented by dcrd.
+func EncryptForPublicKey(pubKeyHex string, data []byte) ([]byte, error) {
+	pubKey, err := ParsePublicKey(pubKeyHex)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := secp256k1.Encrypt(pubKey, data)
+	if err != nil {
+		return nil, newError("seedgen.EncryptForPublicKey", ErrExport, err)
+	}
+	return append(append([]byte{}, encryptedFileMagic...), encrypted...), nil
+}
+
+// DecryptWithWIF decrypts data produced by EncryptForPublicKey using the WIF
+// encoded private key of the recipient.
+func DecryptWithWIF(wif string, data []byte) ([]byte, error) {
+	const op = "seedgen.DecryptWithWIF"
+
+	if !bytes.HasPrefix(data, encryptedFileMagic) {
+		return nil, errorf(op, ErrDecrypt, "data is not a dcrseedgen encrypted export")
+	}
+
+	decodedWIF, _, err := DecodeWIF(strings.TrimSpace(wif))
+	if err != nil {
+		return nil, err
+	}
+	if decodedWIF.DSA() != dcrec.STEcdsaSecp256k1 {
+		return nil, errorf(op, ErrInvalidPrivateKey, "only secp256k1 private keys can decrypt exports")
+	}
+
+	privKey, _ := secp256k1.PrivKeyFromBytes(decodedWIF.PrivKey.Serialize())
+	decrypted, err := secp256k1.Decrypt(privKey, data[len(encryptedFileMagic):])
+	if err != nil {
+		return nil, newError(op, ErrDecrypt, err)
+	}
+	return decrypted, nil
+}
@@ -0,0 +1,83 @@
+package seedgen
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// privateKeyTwo is the secp256k1 private key 2, used where a key other than
+// privateKeyOne is needed.
+var privateKeyTwo = append(make([]byte, 31), 0x02)
+
+func testKeyPair(t *testing.T, network Network, key []byte) *KeyPair {
+	pair, err := GenerateKeyPair(network, GenerateOptions{Entropy: bytes.NewReader(key)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pair
+}
+
+func TestEncryptedExportRoundTrip(t *testing.T) {
+	recipient := testKeyPair(t, Mainnet, privateKeyOne)
+	exported := testKeyPair(t, Testnet3, privateKeyTwo)
+
+	var buf bytes.Buffer
+	err := ExportKeyPairs(&buf, EncryptedCSVExporter{RecipientKey: recipient.PublicKey}, []*KeyPair{exported})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte(exported.WIF())) {
+		t.Fatal("the encrypted export contains the private key in plaintext")
+	}
+
+	rows, err := DecryptExport(bytes.NewReader(buf.Bytes()), recipient.WIF())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{KeyPairCSVHeader, exported.CSVRecord()}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows: got %v, want %v", rows, want)
+	}
+}
+
+func TestDecryptExportErrors(t *testing.T) {
+	recipient := testKeyPair(t, Mainnet, privateKeyOne)
+	other := testKeyPair(t, Mainnet, privateKeyTwo)
+
+	encrypted, err := EncryptForPublicKey(recipient.PublicKey, []byte("address,private_key\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := append([]byte{}, encrypted...)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name string
+		data []byte
+		wif  string
+		kind error
+	}{
+		{"wrong key", encrypted, other.WIF(), ErrDecrypt},
+		{"tampered", tampered, recipient.WIF(), ErrDecrypt},
+		{"plaintext", []byte("address,private_key\n"), recipient.WIF(), ErrDecrypt},
+		{"invalid wif", encrypted, "Pm123", ErrInvalidPrivateKey},
+		{"unknown wif prefix", encrypted, "xprv", ErrUnknownNetwork},
+	}
+	for _, test := range tests {
+		_, err := DecryptExport(bytes.NewReader(test.data), test.wif)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.kind)
+		}
+	}
+}
+
+func TestEncryptForPublicKeyInvalidKey(t *testing.T) {
+	for _, key := range []string{"", "zz", "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f817"} {
+		_, err := EncryptForPublicKey(key, []byte("data"))
+		if !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%q: got %v, want ErrInvalidPublicKey", key, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package seedgen
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+const (
+	KindWIF     = "Private key (WIF)"
+	KindAddress = "Address"
+
+	AddressTypeP2PKH = "Pay-to-pubkey-hash"
+	AddressTypeP2SH  = "Pay-to-script-hash"
+	AddressTypeP2PK  = "Pay-to-pubkey"
+
+	// SignatureTypeUnknown is the name of signature types SignatureTypeName
+	// doesn't know.
+	SignatureTypeUnknown = "unknown"
+)
+
+// Inspection holds the details decoded from a WIF private key or an address.
+type Inspection struct {
+	Kind          string
+	Network       Network
+	SignatureType string
+	AddressType   string
+
+	// PublicKey is the hex encoded compressed public key. It is only known
+	// for WIFs and pay-to-pubkey addresses.
+	PublicKey string
+	Hash160   string
+
+	// Address is the inspected address, or the pay-to-pubkey-hash address
+	// derived from the inspected WIF.
+	Address string
+}
+
+// Inspect decodes a WIF private key or an address for any of the supported
+// networks.
+func Inspect(input string) (*Inspection, error) {
+	const op = "seedgen.Inspect"
+
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, errorf(op, ErrInvalidInput, "nothing to inspect")
+	}
+
+	if NetworkFromWIF(input) != "" {
+		return inspectWIF(input)
+	}
+	if NetworkFromAddress(input) != "" {
+		return inspectAddress(input)
+	}
+	return nil, errorf(op, ErrUnknownNetwork, "input is neither a known private key nor address")
+}
+
+func inspectWIF(wif string) (*Inspection, error) {
+	decodedWIF, network, err := DecodeWIF(wif)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := decodedWIF.SerializePubKey()
+	hash160 := dcrutil.Hash160(pubKey)
+	addr, err := dcrutil.NewAddressPubKeyHash(hash160, network.Params(), decodedWIF.DSA())
+	if err != nil {
+		return nil, newError("seedgen.Inspect", ErrInvalidPrivateKey, err)
+	}
+
+	return &Inspection{
+		Kind:          KindWIF,
+		Network:       network,
+		SignatureType: SignatureTypeName(decodedWIF.DSA()),
+		AddressType:   AddressTypeP2PKH,
+		PublicKey:     hex.EncodeToString(pubKey),
+		Hash160:       hex.EncodeToString(hash160),
+		Address:       addr.Address(),
+	}, nil
+}
+
+func inspectAddress(address string) (*Inspection, error) {
+	network := NetworkFromAddress(address)
+	addr, err := dcrutil.DecodeAddress(address, network.Params())
+	if err != nil {
+		return nil, newError("seedgen.Inspect", ErrInvalidAddress, err)
+	}
+
+	inspection := &Inspection{
+		Kind:    KindAddress,
+		Network: network,
+		Address: addr.Address(),
+	}
+
+	switch a := addr.(type) {
+	case *dcrutil.AddressPubKeyHash:
+		inspection.AddressType = AddressTypeP2PKH
+		inspection.SignatureType = SignatureTypeName(a.DSA())
+		inspection.Hash160 = hex.EncodeToString(a.Hash160()[:])
+	case *dcrutil.AddressScriptHash:
+		inspection.AddressType = AddressTypeP2SH
+		inspection.Hash160 = hex.EncodeToString(a.Hash160()[:])
+	case *dcrutil.AddressSecpPubKey:
+		inspection.AddressType = AddressTypeP2PK
+		inspection.SignatureType = SignatureTypeName(a.DSA())
+		inspection.PublicKey = hex.EncodeToString(a.PubKey().SerializeCompressed())
+		inspection.Hash160 = hex.EncodeToString(a.Hash160()[:])
+	case *dcrutil.AddressEdwardsPubKey:
+		inspection.AddressType = AddressTypeP2PK
+		inspection.SignatureType = SignatureTypeName(a.DSA())
+		inspection.PublicKey = hex.EncodeToString(a.PubKey().SerializeCompressed())
+		inspection.Hash160 = hex.EncodeToString(a.Hash160()[:])
+	case *dcrutil.AddressSecSchnorrPubKey:
+		inspection.AddressType = AddressTypeP2PK
+		inspection.SignatureType = SignatureTypeName(a.DSA())
+		inspection.PublicKey = hex.EncodeToString(a.ScriptAddress())
+		inspection.Hash160 = hex.EncodeToString(a.Hash160()[:])
+	}
+	return inspection, nil
+}
+
+// SignatureTypeName returns a human readable name for a signature type.
+func SignatureTypeName(sigType dcrec.SignatureType) string {
+	switch sigType {
+	case dcrec.STEcdsaSecp256k1:
+		return "secp256k1 ECDSA"
+	case dcrec.STEd25519:
+		return "Ed25519"
+	case dcrec.STSchnorrSecp256k1:
+		return "secp256k1 Schnorr"
+	default:
+		return SignatureTypeUnknown
+	}
+}
@@ -0,0 +1,105 @@
+package seedgen
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+func TestInspect(t *testing.T) {
+	const (
+		publicKey = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+		hash160   = "e280cb6e66b96679aec288b1fbdbd4db08077a1b"
+		address   = "DsmcYVbP1Nmag2H4AS17UTvmWXmGeA7nLDx"
+	)
+
+	pubKey, _ := hex.DecodeString(publicKey)
+	payToPubKey, err := dcrutil.NewAddressSecpPubKey(pubKey, Mainnet.Params())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		want  Inspection
+	}{
+		{
+			input: "PmQdGRXNZdAgEqwDZMLAF2XSQRLFeSFKi4HLPbdW3kC66HegjYtxq",
+			want: Inspection{
+				Kind:          KindWIF,
+				Network:       Mainnet,
+				SignatureType: "secp256k1 ECDSA",
+				AddressType:   "Pay-to-pubkey-hash",
+				PublicKey:     publicKey,
+				Hash160:       hash160,
+				Address:       address,
+			},
+		},
+		{
+			input: " " + address + "\n",
+			want: Inspection{
+				Kind:          KindAddress,
+				Network:       Mainnet,
+				SignatureType: "secp256k1 ECDSA",
+				AddressType:   "Pay-to-pubkey-hash",
+				Hash160:       hash160,
+				Address:       address,
+			},
+		},
+		{
+			input: "TsmfmUitQApgnNxQypdGd2x36djCCpDpERU",
+			want: Inspection{
+				Kind:          KindAddress,
+				Network:       Testnet3,
+				SignatureType: "secp256k1 ECDSA",
+				AddressType:   "Pay-to-pubkey-hash",
+				Hash160:       hash160,
+				Address:       "TsmfmUitQApgnNxQypdGd2x36djCCpDpERU",
+			},
+		},
+		{
+			input: payToPubKey.String(),
+			want: Inspection{
+				Kind:          KindAddress,
+				Network:       Mainnet,
+				SignatureType: "secp256k1 ECDSA",
+				AddressType:   "Pay-to-pubkey",
+				PublicKey:     publicKey,
+				Hash160:       hash160,
+				Address:       address,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		inspection, err := Inspect(test.input)
+		if err != nil {
+			t.Errorf("%s: %v", test.input, err)
+			continue
+		}
+		if *inspection != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.input, *inspection, test.want)
+		}
+	}
+}
+
+func TestInspectErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  error
+	}{
+		{"", ErrInvalidInput},
+		{"  ", ErrInvalidInput},
+		{"1BoatSLRHtKNngkdXEeobR76b53LETtpyT", ErrUnknownNetwork},
+		{"PmQdGRXNZdAgEqwDZMLAF2XSQRLFeSFKi4HLPbdW3kC66HegjYtxr", ErrInvalidPrivateKey},
+		{"DsmcYVbP1Nmag2H4AS17UTvmWXmGeA7nLDy", ErrInvalidAddress},
+	}
+	for _, test := range tests {
+		_, err := Inspect(test.input)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%q: got %v, want %v", test.input, err, test.kind)
+		}
+	}
+}
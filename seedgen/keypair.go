@@ -0,0 +1,109 @@
+package seedgen
+
+import (
+	"encoding/hex"
+	"io"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+var (
+	curve = secp256k1.S256()
+)
+
+// KeyPair holds a generated private key along with the details of its public
+// key and pay-to-pubkey-hash address.
+type KeyPair struct {
+	Address       string
+	PrivateKey    *Secret
+	PublicKey     string
+	Hash160       string
+	SignatureType string
+	Network       Network
+}
+
+// KeyPairCSVHeader names the columns of KeyPair.CSVRecord.
+var KeyPairCSVHeader = []string{"address", "private_key", "public_key", "hash160", "signature_type", "network"}
+
+// CSVRecord returns the key pair fields in the order of KeyPairCSVHeader.
+func (pair *KeyPair) CSVRecord() []string {
+	return []string{pair.Address, pair.WIF(), pair.PublicKey, pair.Hash160, pair.SignatureType, string(pair.Network)}
+}
+
+// WIF returns the private key in wallet import format. It panics once the
+// pair has been wiped, there is no key left to encode.
+func (pair *KeyPair) WIF() string {
+	key := pair.PrivateKey.Bytes()
+	if len(key) == 0 {
+		panic("seedgen: WIF of a wiped key pair")
+	}
+	priv, _ := secp256k1.PrivKeyFromBytes(key)
+	return dcrutil.NewWIF(priv, pair.Network.privKeyID(), dcrec.STEcdsaSecp256k1).String()
+}
+
+// Wipe zeroes the private key.
+func (pair *KeyPair) Wipe() {
+	pair.PrivateKey.Wipe()
+}
+
+// GenerateKeyPair returns a new random secp256k1 key pair for network.
+func GenerateKeyPair(network Network, opts GenerateOptions) (*KeyPair, error) {
+	const op = "seedgen.GenerateKeyPair"
+
+	err := opts.checkOffline(op)
+	if err != nil {
+		return nil, err
+	}
+
+	if !network.valid() {
+		return nil, errorf(op, ErrUnknownNetwork, string(network))
+	}
+
+	privKey, err := randomPrivateKey(opts.entropy())
+	if err != nil {
+		return nil, newError(op, ErrEntropy, err)
+	}
+	_, pub := secp256k1.PrivKeyFromBytes(privKey.Bytes())
+
+	pubKey := pub.SerializeCompressed()
+	hash160 := dcrutil.Hash160(pubKey)
+	addr, err := dcrutil.NewAddressPubKeyHash(
+		hash160,
+		network.Params(),
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		privKey.Wipe()
+		return nil, newError(op, ErrInvalidAddress, err)
+	}
+
+	return &KeyPair{
+		Address:       addr.Address(),
+		PrivateKey:    privKey,
+		PublicKey:     hex.EncodeToString(pubKey),
+		Hash160:       hex.EncodeToString(hash160),
+		SignatureType: SignatureTypeName(dcrec.STEcdsaSecp256k1),
+		Network:       network,
+	}, nil
+}
+
+// randomPrivateKey reads a private key from entropy, skipping the rare values
+// that are not valid secp256k1 private keys.
+func randomPrivateKey(entropy io.Reader) (*Secret, error) {
+	key := make([]byte, 32)
+	defer zero(key)
+
+	for {
+		_, err := io.ReadFull(entropy, key)
+		if err != nil {
+			return nil, err
+		}
+
+		d := new(big.Int).SetBytes(key)
+		if d.Sign() != 0 && d.Cmp(curve.N) < 0 {
+			return NewSecret(key), nil
+		}
+	}
+}
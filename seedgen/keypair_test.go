@@ -0,0 +1,78 @@
+package seedgen
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// privateKeyOne is the secp256k1 private key 1, whose public key is the
+// curve's generator point.
+var privateKeyOne = append(make([]byte, 31), 0x01)
+
+func TestGenerateKeyPair(t *testing.T) {
+	const (
+		publicKey = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+		hash160   = "e280cb6e66b96679aec288b1fbdbd4db08077a1b"
+	)
+
+	tests := []struct {
+		network Network
+		wif     string
+		address string
+	}{
+		{Mainnet, "PmQdGRXNZdAgEqwDZMLAF2XSQRLFeSFKi4HLPbdW3kC66HegjYtxq", "DsmcYVbP1Nmag2H4AS17UTvmWXmGeA7nLDx"},
+		{Testnet3, "PtWTXsGfk2YeqcmrRty77EsynNBtxWLLbsVEeTS8bKAGFoYF3qTNq", "TsmfmUitQApgnNxQypdGd2x36djCCpDpERU"},
+		{Regnet, "Pr9BSiguME5ze2Ve93knpW6TzPEgX9dzyGRGZWAb5TWCsJF55VSWi", "RsUzSvLk8ygma2h7wkP1WtiNjjwjbK4JpiK"},
+		{Simnet, "PsUQ7s99SoUEso9m42SLrffKNd9VPfQSWjPsjYFhvsozGqDu5J3DN", "Sspzuh5xuvqxccYLWJDJjCtqp166NRxcaPB"},
+	}
+
+	for _, test := range tests {
+		pair, err := GenerateKeyPair(test.network, GenerateOptions{Entropy: bytes.NewReader(privateKeyOne)})
+		if err != nil {
+			t.Fatalf("%s: %v", test.network, err)
+		}
+
+		if got := pair.WIF(); got != test.wif {
+			t.Errorf("%s: WIF: got %s, want %s", test.network, got, test.wif)
+		}
+		if pair.Address != test.address {
+			t.Errorf("%s: address: got %s, want %s", test.network, pair.Address, test.address)
+		}
+		if pair.PublicKey != publicKey {
+			t.Errorf("%s: public key: got %s, want %s", test.network, pair.PublicKey, publicKey)
+		}
+		if pair.Hash160 != hash160 {
+			t.Errorf("%s: hash160: got %s, want %s", test.network, pair.Hash160, hash160)
+		}
+		if pair.Network != test.network {
+			t.Errorf("%s: network: got %s", test.network, pair.Network)
+		}
+	}
+}
+
+func TestGenerateKeyPairSkipsInvalidKeys(t *testing.T) {
+	// zero and the curve order are not valid private keys and must be
+	// skipped in favour of the next key read from the entropy source
+	var entropy []byte
+	entropy = append(entropy, make([]byte, 32)...)
+	entropy = append(entropy, curve.N.Bytes()...)
+	entropy = append(entropy, privateKeyOne...)
+
+	pair, err := GenerateKeyPair(Mainnet, GenerateOptions{Entropy: bytes.NewReader(entropy)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "PmQdGRXNZdAgEqwDZMLAF2XSQRLFeSFKi4HLPbdW3kC66HegjYtxq"
+	if got := pair.WIF(); got != want {
+		t.Errorf("WIF: got %s, want %s", got, want)
+	}
+}
+
+func TestGenerateKeyPairUnknownNetwork(t *testing.T) {
+	_, err := GenerateKeyPair("Bitcoin", GenerateOptions{Entropy: bytes.NewReader(privateKeyOne)})
+	if !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("got %v, want ErrUnknownNetwork", err)
+	}
+}
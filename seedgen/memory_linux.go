@@ -0,0 +1,16 @@
+package seedgen
+
+import (
+	"syscall"
+)
+
+func lockMemory(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return syscall.Mlock(data) == nil
+}
+
+func unlockMemory(data []byte) {
+	syscall.Munlock(data)
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+// +build !linux
+
+package seedgen
+
+func lockMemory(data []byte) bool {
+	return false
+}
+
+func unlockMemory(data []byte) {}
@@ -0,0 +1,115 @@
+package seedgen
+
+import (
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+// Network is a Decred network that keys and addresses are generated for.
+type Network string
+
+const (
+	Mainnet  Network = "Mainnet"
+	Testnet3 Network = "Testnet3"
+	Regnet   Network = "Regnet"
+	Simnet   Network = "Simnet"
+)
+
+// Networks lists every supported network.
+var Networks = []Network{Mainnet, Testnet3, Regnet, Simnet}
+
+// ParseNetwork returns the network with the given name.
+func ParseNetwork(name string) (Network, error) {
+	for _, network := range Networks {
+		if string(network) == name {
+			return network, nil
+		}
+	}
+	return "", errorf("seedgen.ParseNetwork", ErrUnknownNetwork, name)
+}
+
+// Params returns the chain parameters of the network.
+func (network Network) Params() *chaincfg.Params {
+	switch network {
+	case Testnet3:
+		return chaincfg.TestNet3Params()
+	case Regnet:
+		return chaincfg.RegNetParams()
+	case Simnet:
+		return chaincfg.SimNetParams()
+	default:
+		return chaincfg.MainNetParams()
+	}
+}
+
+// privKeyID returns the WIF prefix of the network.
+func (network Network) privKeyID() [2]byte {
+	switch network {
+	case Testnet3:
+		return [2]byte{0x23, 0x0e} // starts with Pt
+	case Regnet:
+		return [2]byte{0x22, 0xfe} // starts with Pr
+	case Simnet:
+		return [2]byte{0x23, 0x07} // starts with Ps
+	default:
+		return [2]byte{0x22, 0xde} // starts with Pm
+	}
+}
+
+func (network Network) valid() bool {
+	_, err := ParseNetwork(string(network))
+	return err == nil
+}
+
+// DecodeWIF decodes a WIF private key for any of the supported networks
+// and returns it along with the network it belongs to.
+func DecodeWIF(wif string) (*dcrutil.WIF, Network, error) {
+	const op = "seedgen.DecodeWIF"
+
+	network := NetworkFromWIF(wif)
+	if network == "" {
+		return nil, "", errorf(op, ErrUnknownNetwork, "unknown private key prefix")
+	}
+
+	decoded, err := dcrutil.DecodeWIF(wif, network.privKeyID())
+	if err != nil {
+		return nil, "", newError(op, ErrInvalidPrivateKey, err)
+	}
+	return decoded, network, nil
+}
+
+// NetworkFromWIF returns the network a WIF private key belongs to based on
+// its prefix, or an empty network if it is not recognised.
+func NetworkFromWIF(wif string) Network {
+	switch {
+	case strings.HasPrefix(wif, "Pm"):
+		return Mainnet
+	case strings.HasPrefix(wif, "Pt"):
+		return Testnet3
+	case strings.HasPrefix(wif, "Pr"):
+		return Regnet
+	case strings.HasPrefix(wif, "Ps"):
+		return Simnet
+	default:
+		return ""
+	}
+}
+
+// NetworkFromAddress returns the network an address belongs to based on its
+// prefix, or an empty network if it is not recognised.
+func NetworkFromAddress(address string) Network {
+	switch {
+	case strings.HasPrefix(address, "D"):
+		return Mainnet
+	case strings.HasPrefix(address, "T"):
+		return Testnet3
+	case strings.HasPrefix(address, "R"):
+		return Regnet
+	case strings.HasPrefix(address, "S"):
+		return Simnet
+	default:
+		return ""
+	}
+}
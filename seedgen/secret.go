@@ -0,0 +1,120 @@
+package seedgen
+
+import (
+	"encoding/hex"
+	"sync"
+)
+
+// Secret holds sensitive bytes such as seeds and private keys. Unlike a
+// string its contents can be wiped, which happens when its owner calls Wipe
+// or, with SetSecretTracking, when WipeSecrets is called on exit. Secrets
+// should only be turned into strings where they are drawn or exported.
+type Secret struct {
+	mu     sync.Mutex
+	data   []byte
+	locked bool
+}
+
+var (
+	liveSecretsMu sync.Mutex
+	liveSecrets   = make(map[*Secret]struct{})
+
+	lockSecrets  bool
+	trackSecrets bool
+)
+
+// SetSecretTracking sets whether secrets created from now on are kept track
+// of so that WipeSecrets can wipe them. A tracked secret is only let go of
+// once it is wiped, so it is off by default and meant for applications that
+// wipe everything on exit rather than for library users that drop secrets
+// they are done with.
+func SetSecretTracking(enabled bool) {
+	liveSecretsMu.Lock()
+	defer liveSecretsMu.Unlock()
+	trackSecrets = enabled
+}
+
+// SetMemoryLocking sets whether secrets created from now on are locked into
+// memory so they are never swapped to disk. It is only supported on Linux
+// and is best effort, a secret that can't be locked is still usable.
+func SetMemoryLocking(enabled bool) {
+	liveSecretsMu.Lock()
+	defer liveSecretsMu.Unlock()
+	lockSecrets = enabled
+}
+
+// NewSecret moves data into a new secret and zeroes data.
+func NewSecret(data []byte) *Secret {
+	secret := &Secret{
+		data: make([]byte, len(data)),
+	}
+	copy(secret.data, data)
+	zero(data)
+
+	liveSecretsMu.Lock()
+	defer liveSecretsMu.Unlock()
+	if lockSecrets {
+		secret.locked = lockMemory(secret.data)
+	}
+	if trackSecrets {
+		liveSecrets[secret] = struct{}{}
+	}
+	return secret
+}
+
+// Bytes returns the secret bytes. The slice is wiped along with the secret
+// so it must not be kept around.
+func (s *Secret) Bytes() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data
+}
+
+// Hex returns the secret hex encoded, for drawing it.
+func (s *Secret) Hex() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return hex.EncodeToString(s.data)
+}
+
+// Wipe zeroes the secret. It is safe to call more than once and on a nil
+// secret.
+func (s *Secret) Wipe() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	zero(s.data)
+	if s.locked {
+		unlockMemory(s.data)
+		s.locked = false
+	}
+	s.data = nil
+	s.mu.Unlock()
+
+	liveSecretsMu.Lock()
+	delete(liveSecrets, s)
+	liveSecretsMu.Unlock()
+}
+
+// WipeSecrets wipes every tracked secret that is still alive, e.g. before
+// exiting, see SetSecretTracking.
+func WipeSecrets() {
+	liveSecretsMu.Lock()
+	secrets := make([]*Secret, 0, len(liveSecrets))
+	for secret := range liveSecrets {
+		secrets = append(secrets, secret)
+	}
+	liveSecretsMu.Unlock()
+
+	for _, secret := range secrets {
+		secret.Wipe()
+	}
+}
+
+func zero(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
@@ -0,0 +1,62 @@
+package seedgen
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewSecretZeroesInput(t *testing.T) {
+	data := []byte{1, 2, 3}
+	secret := NewSecret(data)
+	defer secret.Wipe()
+
+	if !bytes.Equal(data, make([]byte, 3)) {
+		t.Errorf("input: got %x, want it zeroed", data)
+	}
+	if got := secret.Hex(); got != "010203" {
+		t.Errorf("hex: got %s, want 010203", got)
+	}
+}
+
+func TestSecretTracking(t *testing.T) {
+	untracked := NewSecret([]byte{1})
+
+	SetSecretTracking(true)
+	tracked := NewSecret([]byte{2})
+	wiped := NewSecret([]byte{3})
+	SetSecretTracking(false)
+
+	wiped.Wipe()
+	liveSecretsMu.Lock()
+	_, trackedLive := liveSecrets[tracked]
+	_, untrackedLive := liveSecrets[untracked]
+	_, wipedLive := liveSecrets[wiped]
+	liveSecretsMu.Unlock()
+	if !trackedLive || untrackedLive || wipedLive {
+		t.Errorf("tracked: got %v %v %v, want only the secret created while tracking", trackedLive, untrackedLive, wipedLive)
+	}
+
+	WipeSecrets()
+	if tracked.Bytes() != nil {
+		t.Error("WipeSecrets left a tracked secret")
+	}
+	if untracked.Bytes() == nil {
+		t.Error("WipeSecrets wiped a secret created while tracking was off")
+	}
+	untracked.Wipe()
+}
+
+func TestKeyPairWIFAfterWipe(t *testing.T) {
+	pair, err := GenerateKeyPair(Mainnet, GenerateOptions{Entropy: bytes.NewReader(privateKeyOne)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair.Wipe()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("WIF of a wiped key pair did not panic")
+		}
+	}()
+	pair.WIF()
+}
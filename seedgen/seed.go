@@ -0,0 +1,124 @@
+package seedgen
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/pgpwordlist"
+	"github.com/decred/dcrwallet/walletseed"
+)
+
+const (
+	// DefaultSeedSize is the seed size used by dcrwallet, encoded as 33
+	// words.
+	DefaultSeedSize = 32
+
+	MinSeedSize = hdkeychain.MinSeedBytes
+	MaxSeedSize = hdkeychain.MaxSeedBytes
+)
+
+// GenerateOptions configures the generation of seeds and keys. The zero
+// value generates with crypto/rand whether or not the machine is online.
+type GenerateOptions struct {
+	// Entropy is the source of randomness, crypto/rand.Reader if nil. Only
+	// tests should set it.
+	Entropy io.Reader
+
+	// RequireOffline makes generating fail with ErrOnline while the
+	// machine has a network connection, or with ErrConnectivityCheck if
+	// that can't be checked, see CheckConnectivity.
+	RequireOffline bool
+}
+
+func (opts GenerateOptions) entropy() io.Reader {
+	if opts.Entropy == nil {
+		return rand.Reader
+	}
+	return opts.Entropy
+}
+
+func (opts GenerateOptions) checkOffline(op string) error {
+	if !opts.RequireOffline {
+		return nil
+	}
+
+	status, err := CheckConnectivity()
+	if err != nil {
+		return newError(op, ErrConnectivityCheck, err)
+	}
+	if status.Online() {
+		return errorf(op, ErrOnline, status.String())
+	}
+	return nil
+}
+
+// Seed is a dcrwallet seed.
+type Seed struct {
+	secret *Secret
+}
+
+// GenerateSeed returns a new random wallet seed of size bytes.
+func GenerateSeed(size uint, opts GenerateOptions) (*Seed, error) {
+	const op = "seedgen.GenerateSeed"
+
+	err := opts.checkOffline(op)
+	if err != nil {
+		return nil, err
+	}
+
+	if size < MinSeedSize || size > MaxSeedSize {
+		return nil, newError(op, ErrInvalidSeedSize, hdkeychain.ErrInvalidSeedLen)
+	}
+
+	seed := make([]byte, size)
+	_, err = io.ReadFull(opts.entropy(), seed)
+	if err != nil {
+		zero(seed)
+		return nil, newError(op, ErrEntropy, err)
+	}
+
+	return &Seed{secret: NewSecret(seed)}, nil
+}
+
+// Words returns the mnemonic words of the seed, including the checksum word.
+func (seed *Seed) Words() []string {
+	return walletseed.EncodeMnemonicSlice(seed.secret.Bytes())
+}
+
+// Word returns the mnemonic word at index, the last one being the checksum
+// word. Unlike Words it leaves the other words alone, e.g. for drawing one
+// revealed word.
+func (seed *Seed) Word(index int) string {
+	data := seed.secret.Bytes()
+	switch {
+	case index >= 0 && index < len(data):
+		return pgpwordlist.ByteToMnemonic(data[index], index)
+	case index == len(data):
+		return pgpwordlist.ByteToMnemonic(seedChecksum(data), index)
+	default:
+		panic("seedgen: seed word index out of range")
+	}
+}
+
+// seedChecksum returns the checksum word's byte, the first byte of the
+// double SHA-256 of the seed.
+func seedChecksum(data []byte) byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	checksum := second[0]
+	zero(first[:])
+	zero(second[:])
+	return checksum
+}
+
+// Hex returns the seed hex encoded.
+func (seed *Seed) Hex() string {
+	return seed.secret.Hex()
+}
+
+// Wipe zeroes the seed.
+func (seed *Seed) Wipe() {
+	seed.secret.Wipe()
+}
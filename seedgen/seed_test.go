@@ -0,0 +1,69 @@
+package seedgen
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSeed(t *testing.T) {
+	entropy := make([]byte, 32)
+	for i := range entropy {
+		entropy[i] = byte(i)
+	}
+
+	seed, err := GenerateSeed(32, GenerateOptions{Entropy: bytes.NewReader(entropy)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantHex := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	if got := seed.Hex(); got != wantHex {
+		t.Errorf("hex: got %s, want %s", got, wantHex)
+	}
+
+	wantWords := "aardvark adviser accrue aggregate adrift almighty afflict amusement " +
+		"aimless applicant allow armistice ammo asteroid apple atmosphere assume " +
+		"Babylon atlas barbecue baboon bifocals backward bookseller beaming " +
+		"bottomless beehive bravado befriend breakaway berserk businessman cement"
+	if got := strings.Join(seed.Words(), " "); got != wantWords {
+		t.Errorf("mnemonic: got %s, want %s", got, wantWords)
+	}
+}
+
+func TestGenerateSeedInvalidSize(t *testing.T) {
+	for _, size := range []uint{0, 15, 65} {
+		_, err := GenerateSeed(size, GenerateOptions{Entropy: bytes.NewReader(make([]byte, 128))})
+		if !errors.Is(err, ErrInvalidSeedSize) {
+			t.Errorf("size %d: got %v, want ErrInvalidSeedSize", size, err)
+		}
+	}
+}
+
+func TestGenerateSeedShortEntropy(t *testing.T) {
+	_, err := GenerateSeed(32, GenerateOptions{Entropy: bytes.NewReader(make([]byte, 31))})
+	if !errors.Is(err, ErrEntropy) {
+		t.Errorf("got %v, want ErrEntropy when the entropy source runs out", err)
+	}
+}
+
+func TestSeedWord(t *testing.T) {
+	for _, size := range []uint{MinSeedSize, DefaultSeedSize, MaxSeedSize} {
+		entropy := make([]byte, size)
+		for i := range entropy {
+			entropy[i] = byte(i * 7)
+		}
+		seed, err := GenerateSeed(size, GenerateOptions{Entropy: bytes.NewReader(entropy)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		words := seed.Words()
+		for i, word := range words {
+			if got := seed.Word(i); got != word {
+				t.Errorf("%d bytes: word %d: got %s, want %s", size, i, got, word)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package seedgen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// signedMessageMagic is prepended to every message before hashing, matching
+// dcrwallet's signmessage and dcrd's verifymessage.
+const signedMessageMagic = "Decred Signed Message:\n"
+
+func signedMessageHash(message string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, signedMessageMagic)
+	wire.WriteVarString(&buf, 0, message)
+	return chainhash.HashB(buf.Bytes())
+}
+
+// SignMessage signs message with the WIF encoded private key and returns the
+// base64 encoded compact signature accepted by dcrd's verifymessage.
+func SignMessage(wif, message string) (string, error) {
+	const op = "seedgen.SignMessage"
+
+	decodedWIF, _, err := DecodeWIF(strings.TrimSpace(wif))
+	if err != nil {
+		return "", err
+	}
+	if decodedWIF.DSA() != dcrec.STEcdsaSecp256k1 {
+		return "", errorf(op, ErrInvalidPrivateKey, "only secp256k1 private keys can sign messages")
+	}
+
+	return signMessage(op, decodedWIF.PrivKey.Serialize(), message)
+}
+
+// SignMessage signs message with the private key of the pair, the same way
+// the package level SignMessage does with its WIF, without encoding the key.
+func (pair *KeyPair) SignMessage(message string) (string, error) {
+	const op = "seedgen.KeyPair.SignMessage"
+
+	key := pair.PrivateKey.Bytes()
+	if len(key) == 0 {
+		return "", errorf(op, ErrInvalidPrivateKey, "the key pair has been wiped")
+	}
+	return signMessage(op, key, message)
+}
+
+func signMessage(op string, key []byte, message string) (string, error) {
+	privKey, _ := secp256k1.PrivKeyFromBytes(key)
+	signature, err := secp256k1.SignCompact(privKey, signedMessageHash(message), true)
+	if err != nil {
+		return "", newError(op, ErrInvalidPrivateKey, err)
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// VerifyMessage reports whether signature is a valid signature of message by
+// the private key behind the pay-to-pubkey-hash address.
+func VerifyMessage(address, message, signature string) (bool, error) {
+	const op = "seedgen.VerifyMessage"
+
+	address = strings.TrimSpace(address)
+	network := NetworkFromAddress(address)
+	if network == "" {
+		return false, errorf(op, ErrUnknownNetwork, "unknown address prefix")
+	}
+
+	chainParams := network.Params()
+	_, err := dcrutil.DecodeAddress(address, chainParams)
+	if err != nil {
+		return false, newError(op, ErrInvalidAddress, err)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return false, errorf(op, ErrInvalidSignature, "signature is not valid base64")
+	}
+
+	pubKey, wasCompressed, err := secp256k1.RecoverCompact(sig, signedMessageHash(message))
+	if err != nil {
+		// a signature that does not recover a key is simply not valid
+		return false, nil
+	}
+
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if wasCompressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	recoveredAddress, err := dcrutil.NewAddressSecpPubKey(serializedPubKey, chainParams)
+	if err != nil {
+		return false, newError(op, ErrInvalidSignature, err)
+	}
+	return recoveredAddress.Address() == address, nil
+}
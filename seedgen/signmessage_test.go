@@ -0,0 +1,151 @@
+package seedgen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+const (
+	testMessage = "Hello, Decred!"
+
+	// testSignature is the signature of testMessage by the mainnet key of
+	// privateKeyOne. Signing is deterministic (RFC 6979) so it never
+	// changes.
+	testSignature = "IMt9GVkyCJFbNg8fHwOj1mgHEACc5qpeVpr8wrTYlPQ2UrRZKGWKADatrqgQEnck+YXlsNmYtlHzB9nI/NhyXvg="
+)
+
+// dcrdVerifyMessage checks a signature the way dcrd's verifymessage RPC
+// does, written out separately from VerifyMessage so that the two are
+// compared with each other.
+func dcrdVerifyMessage(t *testing.T, address, message, signature string, network Network) bool {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
+	wire.WriteVarString(&buf, 0, message)
+	pk, wasCompressed, err := secp256k1.RecoverCompact(sig, chainhash.HashB(buf.Bytes()))
+	if err != nil {
+		return false
+	}
+
+	serializedPK := pk.SerializeUncompressed()
+	if wasCompressed {
+		serializedPK = pk.SerializeCompressed()
+	}
+	addr, err := dcrutil.NewAddressSecpPubKey(serializedPK, network.Params())
+	if err != nil {
+		return false
+	}
+	return addr.Address() == address
+}
+
+func TestSignMessage(t *testing.T) {
+	pair := testKeyPair(t, Mainnet, privateKeyOne)
+
+	signature, err := SignMessage(pair.WIF(), testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != testSignature {
+		t.Errorf("signature: got %s, want %s", signature, testSignature)
+	}
+	if !dcrdVerifyMessage(t, pair.Address, testMessage, signature, Mainnet) {
+		t.Error("dcrd does not accept the signature")
+	}
+}
+
+func TestKeyPairSignMessage(t *testing.T) {
+	pair := testKeyPair(t, Mainnet, privateKeyOne)
+
+	signature, err := pair.SignMessage(testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != testSignature {
+		t.Errorf("signature: got %s, want %s", signature, testSignature)
+	}
+
+	pair.Wipe()
+	_, err = pair.SignMessage(testMessage)
+	if !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("wiped pair: got %v, want ErrInvalidPrivateKey", err)
+	}
+}
+
+func TestVerifyMessage(t *testing.T) {
+	mainnet := testKeyPair(t, Mainnet, privateKeyOne)
+	testnet := testKeyPair(t, Testnet3, privateKeyTwo)
+
+	testnetSignature, err := SignMessage(testnet.WIF(), testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dcrdVerifyMessage(t, testnet.Address, testMessage, testnetSignature, Testnet3) {
+		t.Error("dcrd does not accept the testnet signature")
+	}
+
+	tests := []struct {
+		name      string
+		address   string
+		message   string
+		signature string
+		valid     bool
+	}{
+		{"mainnet", mainnet.Address, testMessage, testSignature, true},
+		{"testnet", testnet.Address, testMessage, testnetSignature, true},
+		{"surrounding space", " " + mainnet.Address + "\n", testMessage, testSignature + "\n", true},
+		{"other message", mainnet.Address, testMessage + ".", testSignature, false},
+		{"other address", testnet.Address, testMessage, testSignature, false},
+		{"truncated signature", mainnet.Address, testMessage, testSignature[:20], false},
+	}
+	for _, test := range tests {
+		valid, err := VerifyMessage(test.address, test.message, test.signature)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if valid != test.valid {
+			t.Errorf("%s: got valid %v, want %v", test.name, valid, test.valid)
+		}
+	}
+}
+
+func TestVerifyMessageErrors(t *testing.T) {
+	address := testKeyPair(t, Mainnet, privateKeyOne).Address
+
+	tests := []struct {
+		name      string
+		address   string
+		signature string
+		kind      error
+	}{
+		{"unknown prefix", "1BoatSLRHtKNngkdXEeobR76b53LETtpyT", testSignature, ErrUnknownNetwork},
+		{"bad checksum", address[:len(address)-1] + "y", testSignature, ErrInvalidAddress},
+		{"bad base64", address, "not base64!", ErrInvalidSignature},
+	}
+	for _, test := range tests {
+		_, err := VerifyMessage(test.address, testMessage, test.signature)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.kind)
+		}
+	}
+}
+
+func TestSignMessageInvalidWIF(t *testing.T) {
+	for _, wif := range []string{"", "Pm123", "xprv"} {
+		_, err := SignMessage(wif, testMessage)
+		if err == nil {
+			t.Errorf("%q: signed with an invalid private key", wif)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package seedgen
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/chaincfg/v2/chainec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// PrevOutput is the script and amount of an output spent by a transaction
+// input. Decred signatures do not commit to the amount but it is still set as
+// the input's ValueIn so the signed transaction is complete.
+type PrevOutput struct {
+	Script []byte
+	Amount dcrutil.Amount
+}
+
+// SignedTransaction is a transaction with every input its keys could sign.
+type SignedTransaction struct {
+	Hex          string
+	SignedInputs int
+	TotalInputs  int
+}
+
+// signingKeys indexes decoded private keys by the pay-to-pubkey-hash address
+// they control. All keys must belong to the same network.
+type signingKeys struct {
+	network     Network
+	chainParams *chaincfg.Params
+	keys        map[string]*dcrutil.WIF
+}
+
+// ParsePrevOutputs parses one previous output per line, given as the hex
+// encoded script followed by the amount in DCR, in the order of the inputs.
+func ParsePrevOutputs(text string) ([]PrevOutput, error) {
+	const op = "seedgen.ParsePrevOutputs"
+
+	var prevOutputs []PrevOutput
+	for i, line := range nonEmptyLines(text) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("line %d: expected a script and an amount", i+1))
+		}
+
+		script, err := hex.DecodeString(fields[0])
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("line %d: script is not valid hex", i+1))
+		}
+
+		amount, err := parseAmount(fields[1])
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("line %d: %s", i+1, err.Error()))
+		}
+
+		prevOutputs = append(prevOutputs, PrevOutput{Script: script, Amount: amount})
+	}
+	return prevOutputs, nil
+}
+
+// ParsePrivateKeys returns the WIF private keys listed one per line.
+func ParsePrivateKeys(text string) []string {
+	return nonEmptyLines(text)
+}
+
+// SignTransaction signs every input of the hex encoded unsigned transaction
+// that spends an output paying to one of the WIF private keys.
+func SignTransaction(txHex string, prevOutputs []PrevOutput, wifs []string) (*SignedTransaction, error) {
+	const op = "seedgen.SignTransaction"
+
+	txBytes, err := hex.DecodeString(strings.TrimSpace(txHex))
+	if err != nil {
+		return nil, errorf(op, ErrInvalidTx, "transaction is not valid hex")
+	}
+
+	tx := wire.NewMsgTx()
+	err = tx.Deserialize(bytes.NewReader(txBytes))
+	if err != nil {
+		return nil, newError(op, ErrInvalidTx, err)
+	}
+
+	keys, err := newSigningKeys(op, wifs)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := signInputs(op, tx, prevOutputs, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	signedBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, newError(op, ErrInvalidTx, err)
+	}
+
+	return &SignedTransaction{
+		Hex:          hex.EncodeToString(signedBytes),
+		SignedInputs: signed,
+		TotalInputs:  len(tx.TxIn),
+	}, nil
+}
+
+func newSigningKeys(op string, wifs []string) (*signingKeys, error) {
+	if len(wifs) == 0 {
+		return nil, errorf(op, ErrInvalidPrivateKey, "no private keys to sign with")
+	}
+
+	signing := &signingKeys{
+		keys: make(map[string]*dcrutil.WIF, len(wifs)),
+	}
+	for _, wif := range wifs {
+		decodedWIF, network, err := DecodeWIF(strings.TrimSpace(wif))
+		if err != nil {
+			return nil, err
+		}
+
+		if signing.network == "" {
+			signing.network = network
+			signing.chainParams = network.Params()
+		} else if signing.network != network {
+			return nil, errorf(op, ErrUnknownNetwork, "all private keys must belong to the same network")
+		}
+
+		addr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(decodedWIF.SerializePubKey()),
+			signing.chainParams, decodedWIF.DSA())
+		if err != nil {
+			return nil, newError(op, ErrInvalidPrivateKey, err)
+		}
+		signing.keys[addr.Address()] = decodedWIF
+	}
+	return signing, nil
+}
+
+// signInputs signs the inputs of tx spending outputs controlled by keys and
+// returns how many were signed.
+func signInputs(op string, tx *wire.MsgTx, prevOutputs []PrevOutput, keys *signingKeys) (int, error) {
+	if len(prevOutputs) != len(tx.TxIn) {
+		return 0, errorf(op, ErrInvalidInput, fmt.Sprintf("transaction has %d inputs but %d previous outputs were given",
+			len(tx.TxIn), len(prevOutputs)))
+	}
+
+	getKey := txscript.KeyClosure(func(addr dcrutil.Address) (chainec.PrivateKey, bool, error) {
+		wif, ok := keys.keys[addr.Address()]
+		if !ok {
+			return nil, false, errors.New("no private key for address")
+		}
+		return wif.PrivKey, true, nil
+	})
+	getScript := txscript.ScriptClosure(func(addr dcrutil.Address) ([]byte, error) {
+		return nil, errors.New("pay-to-script-hash inputs are not supported")
+	})
+
+	signed := 0
+	for i, prevOutput := range prevOutputs {
+		tx.TxIn[i].ValueIn = int64(prevOutput.Amount)
+
+		// only version 0 scripts are standard
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(0, prevOutput.Script, keys.chainParams)
+		if err != nil || len(addrs) != 1 {
+			continue
+		}
+		wif, ok := keys.keys[addrs[0].Address()]
+		if !ok {
+			continue
+		}
+
+		sigScript, err := txscript.SignTxOutput(keys.chainParams, tx, i, prevOutput.Script,
+			txscript.SigHashAll, getKey, getScript, tx.TxIn[i].SignatureScript, wif.DSA())
+		if err != nil {
+			return signed, errorf(op, ErrInvalidTx, fmt.Sprintf("error signing input %d: %s", i, err.Error()))
+		}
+		tx.TxIn[i].SignatureScript = sigScript
+		signed++
+	}
+	return signed, nil
+}
+
+// parseAmount parses an amount in DCR.
+func parseAmount(amountStr string) (dcrutil.Amount, error) {
+	value, err := strconv.ParseFloat(amountStr, 64)
+	if err != nil || value < 0 {
+		return 0, errors.New("amount is not a valid number of DCR")
+	}
+	return dcrutil.NewAmount(value)
+}
+
+func nonEmptyLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,152 @@
+package seedgen
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// payToPair returns the pay-to-pubkey-hash script of pair.
+func payToPair(t *testing.T, pair *KeyPair) []byte {
+	addr, err := dcrutil.DecodeAddress(pair.Address, pair.Network.Params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return script
+}
+
+// unsignedTx returns a transaction spending one output for every previous
+// output to the script of to.
+func unsignedTx(t *testing.T, prevOutputs []PrevOutput, to []byte) string {
+	tx := wire.NewMsgTx()
+	for i := range prevOutputs {
+		hash := chainhash.Hash{byte(i + 1)}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&hash, uint32(i), wire.TxTreeRegular), 0, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(1e8, to))
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(txBytes)
+}
+
+// verifyInputs runs the script of every input of the hex encoded transaction
+// and returns how many of them are valid.
+func verifyInputs(t *testing.T, txHex string, prevOutputs []PrevOutput) int {
+	txBytes, _ := hex.DecodeString(txHex)
+	tx := wire.NewMsgTx()
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := 0
+	for i, prevOutput := range prevOutputs {
+		vm, err := txscript.NewEngine(prevOutput.Script, tx, i, 0, 0, nil)
+		if err != nil {
+			continue
+		}
+		if vm.Execute() == nil {
+			valid++
+		}
+	}
+	return valid
+}
+
+func TestSignTransaction(t *testing.T) {
+	one := testKeyPair(t, Testnet3, privateKeyOne)
+	two := testKeyPair(t, Testnet3, privateKeyTwo)
+
+	prevOutputs := []PrevOutput{
+		{Script: payToPair(t, one), Amount: 1e8},
+		{Script: payToPair(t, two), Amount: 2e8},
+	}
+	txHex := unsignedTx(t, prevOutputs, payToPair(t, one))
+
+	tests := []struct {
+		name   string
+		wifs   []string
+		signed int
+	}{
+		{"partial", []string{one.WIF()}, 1},
+		{"full", []string{one.WIF(), two.WIF()}, 2},
+		{"keys in any order", []string{" " + two.WIF(), one.WIF() + "\n"}, 2},
+	}
+	for _, test := range tests {
+		signed, err := SignTransaction(txHex, prevOutputs, test.wifs)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if signed.SignedInputs != test.signed || signed.TotalInputs != len(prevOutputs) {
+			t.Errorf("%s: signed %d of %d inputs, want %d of %d", test.name,
+				signed.SignedInputs, signed.TotalInputs, test.signed, len(prevOutputs))
+		}
+		if valid := verifyInputs(t, signed.Hex, prevOutputs); valid != test.signed {
+			t.Errorf("%s: %d inputs pass script verification, want %d", test.name, valid, test.signed)
+		}
+	}
+}
+
+func TestSignTransactionErrors(t *testing.T) {
+	one := testKeyPair(t, Testnet3, privateKeyOne)
+	mainnet := testKeyPair(t, Mainnet, privateKeyTwo)
+
+	prevOutputs := []PrevOutput{{Script: payToPair(t, one), Amount: 1e8}}
+	txHex := unsignedTx(t, prevOutputs, payToPair(t, one))
+
+	tests := []struct {
+		name        string
+		txHex       string
+		prevOutputs []PrevOutput
+		wifs        []string
+		kind        error
+	}{
+		{"not hex", "zz", prevOutputs, []string{one.WIF()}, ErrInvalidTx},
+		{"truncated", txHex[:20], prevOutputs, []string{one.WIF()}, ErrInvalidTx},
+		{"no keys", txHex, prevOutputs, nil, ErrInvalidPrivateKey},
+		{"mixed networks", txHex, prevOutputs, []string{one.WIF(), mainnet.WIF()}, ErrUnknownNetwork},
+		{"missing previous output", txHex, nil, []string{one.WIF()}, ErrInvalidInput},
+	}
+	for _, test := range tests {
+		_, err := SignTransaction(test.txHex, test.prevOutputs, test.wifs)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.kind)
+		}
+	}
+}
+
+func TestParsePrevOutputs(t *testing.T) {
+	prevOutputs, err := ParsePrevOutputs("76a914 1.5\n\n  a914 0.00000001  \n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []PrevOutput{
+		{Script: []byte{0x76, 0xa9, 0x14}, Amount: 150000000},
+		{Script: []byte{0xa9, 0x14}, Amount: 1},
+	}
+	if len(prevOutputs) != len(want) {
+		t.Fatalf("got %d previous outputs, want %d", len(prevOutputs), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(prevOutputs[i].Script, want[i].Script) || prevOutputs[i].Amount != want[i].Amount {
+			t.Errorf("line %d: got %+v, want %+v", i+1, prevOutputs[i], want[i])
+		}
+	}
+
+	for _, text := range []string{"76a914", "zz 1", "76a914 -1", "76a914 one"} {
+		_, err := ParsePrevOutputs(text)
+		if !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%q: got %v, want ErrInvalidInput", text, err)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package seedgen
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+const (
+	// redeemP2PKHInputSize is the worst case size of an input spending a
+	// compressed pay-to-pubkey-hash output: the outpoint (32 + 4 + 1), the
+	// witness amount, block height and index (8 + 4 + 4), the signature
+	// script with its length (1 + 1 + 73 + 1 + 33) and the sequence (4).
+	redeemP2PKHInputSize = 32 + 4 + 1 + 8 + 4 + 4 + 1 + 108 + 4
+
+	// DefaultSweepFeeRate is the relay fee used by dcrd and dcrwallet, in DCR
+	// per kB.
+	DefaultSweepFeeRate = "0.0001"
+
+	// relayFeePerKB is DefaultSweepFeeRate in atoms, dcrd decides which
+	// outputs are dust by it.
+	relayFeePerKB dcrutil.Amount = 1e4
+
+	// redeemP2PKHInputAverageSize is the average size of an input spending
+	// a compressed pay-to-pubkey-hash output, which dcrd's dust rule assumes
+	// every output will be spent with.
+	redeemP2PKHInputAverageSize = 165
+)
+
+// UTXO is an unspent output listed in a sweep's UTXO file.
+type UTXO struct {
+	Hash   chainhash.Hash
+	Index  uint32
+	Amount dcrutil.Amount
+	Script []byte
+}
+
+// Sweep is an unsigned transaction moving every UTXO to a single
+// destination, kept for review until it is signed.
+type Sweep struct {
+	tx          *wire.MsgTx
+	prevOutputs []PrevOutput
+	network     Network
+
+	Destination   string
+	InputAmount   dcrutil.Amount
+	Fee           dcrutil.Amount
+	OutputAmount  dcrutil.Amount
+	EstimatedSize int
+}
+
+// ParseUTXOs reads CSV with a "txid,vout,amount,script" row for every output
+// to sweep. Amounts are in DCR and a header row is optional.
+func ParseUTXOs(r io.Reader) ([]UTXO, error) {
+	const op = "seedgen.ParseUTXOs"
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, newError(op, ErrInvalidInput, err)
+	}
+	if len(rows) > 0 && rows[0][0] == "txid" {
+		rows = rows[1:]
+	}
+
+	utxos := make([]UTXO, len(rows))
+	for i, row := range rows {
+		hash, err := chainhash.NewHashFromStr(row[0])
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("row %d: invalid txid", i+1))
+		}
+		index, err := strconv.ParseUint(row[1], 10, 32)
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("row %d: invalid vout", i+1))
+		}
+		amount, err := parseAmount(row[2])
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("row %d: %s", i+1, err.Error()))
+		}
+		script, err := hex.DecodeString(row[3])
+		if err != nil {
+			return nil, errorf(op, ErrInvalidInput, fmt.Sprintf("row %d: script is not valid hex", i+1))
+		}
+
+		utxos[i] = UTXO{Hash: *hash, Index: uint32(index), Amount: amount, Script: script}
+	}
+	return utxos, nil
+}
+
+// BuildSweep builds an unsigned transaction spending every UTXO to the
+// destination address, paying feeRate DCR per kB.
+func BuildSweep(utxos []UTXO, destination, feeRate string) (*Sweep, error) {
+	const op = "seedgen.BuildSweep"
+
+	if len(utxos) == 0 {
+		return nil, errorf(op, ErrInvalidInput, "no outputs to sweep")
+	}
+
+	destination = strings.TrimSpace(destination)
+	network := NetworkFromAddress(destination)
+	if network == "" {
+		return nil, errorf(op, ErrUnknownNetwork, "unknown destination address network")
+	}
+	destinationAddr, err := dcrutil.DecodeAddress(destination, network.Params())
+	if err != nil {
+		return nil, newError(op, ErrInvalidAddress, err)
+	}
+	destinationScript, err := txscript.PayToAddrScript(destinationAddr)
+	if err != nil {
+		return nil, newError(op, ErrInvalidAddress, err)
+	}
+
+	feePerKB, err := parseAmount(strings.TrimSpace(feeRate))
+	if err != nil {
+		return nil, errorf(op, ErrInvalidInput, "fee rate is not a valid number of DCR per kB")
+	}
+
+	sweep := &Sweep{
+		tx:          wire.NewMsgTx(),
+		prevOutputs: make([]PrevOutput, len(utxos)),
+		network:     network,
+		Destination: destination,
+	}
+	for i := range utxos {
+		outPoint := wire.NewOutPoint(&utxos[i].Hash, utxos[i].Index, wire.TxTreeRegular)
+		sweep.tx.AddTxIn(wire.NewTxIn(outPoint, int64(utxos[i].Amount), nil))
+		sweep.prevOutputs[i] = PrevOutput{Script: utxos[i].Script, Amount: utxos[i].Amount}
+		sweep.InputAmount += utxos[i].Amount
+	}
+
+	sweep.EstimatedSize = estimateSweepSize(len(utxos), len(destinationScript))
+	// round up so that the fee never falls below the rate asked for
+	sweep.Fee = (feePerKB*dcrutil.Amount(sweep.EstimatedSize) + 999) / 1000
+	sweep.OutputAmount = sweep.InputAmount - sweep.Fee
+	if sweep.OutputAmount <= 0 {
+		return nil, errorf(op, ErrInvalidInput, "the fee is larger than the amount being swept")
+	}
+	if isDustAmount(sweep.OutputAmount, len(destinationScript)) {
+		return nil, errorf(op, ErrInvalidInput, "the amount left after the fee is too small to be relayed")
+	}
+
+	sweep.tx.AddTxOut(wire.NewTxOut(int64(sweep.OutputAmount), destinationScript))
+	return sweep, nil
+}
+
+// Sign signs the sweep with the private keys of the swept outputs. Every
+// input must be signed for the sweep to be valid.
+func (sweep *Sweep) Sign(wifs []string) (*SignedTransaction, error) {
+	const op = "seedgen.Sweep.Sign"
+
+	keys, err := newSigningKeys(op, wifs)
+	if err != nil {
+		return nil, err
+	}
+	if keys.network != sweep.network {
+		return nil, errorf(op, ErrUnknownNetwork, "private keys and destination address belong to different networks")
+	}
+
+	tx := sweep.tx.Copy()
+	signed, err := signInputs(op, tx, sweep.prevOutputs, keys)
+	if err != nil {
+		return nil, err
+	}
+	if signed != len(tx.TxIn) {
+		return nil, errorf(op, ErrInvalidPrivateKey, fmt.Sprintf("only %d of %d inputs could be signed with the given keys", signed, len(tx.TxIn)))
+	}
+
+	signedBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, newError(op, ErrInvalidTx, err)
+	}
+	return &SignedTransaction{
+		Hex:          hex.EncodeToString(signedBytes),
+		SignedInputs: signed,
+		TotalInputs:  len(tx.TxIn),
+	}, nil
+}
+
+// isDustAmount reports whether an output of amount paying to a script of
+// scriptSize bytes is dust, i.e. costs more than a third of its value to
+// spend at the relay fee. It is the rule dcrd and dcrwallet apply, outputs
+// it matches are not relayed.
+func isDustAmount(amount dcrutil.Amount, scriptSize int) bool {
+	totalSize := 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) + scriptSize + redeemP2PKHInputAverageSize
+	return int64(amount)*1000/(3*int64(totalSize)) < int64(relayFeePerKB)
+}
+
+// estimateSweepSize returns the worst case serialized size of a sweep with
+// numInputs pay-to-pubkey-hash inputs and one output.
+func estimateSweepSize(numInputs, outputScriptSize int) int {
+	// version, lock time and expiry
+	size := 4 + 4 + 4
+	// input counts for the prefix and witness
+	size += 2 * wire.VarIntSerializeSize(uint64(numInputs))
+	size += numInputs * redeemP2PKHInputSize
+	// output count, amount, script version and script
+	size += wire.VarIntSerializeSize(1)
+	size += 8 + 2 + wire.VarIntSerializeSize(uint64(outputScriptSize)) + outputScriptSize
+	return size
+}
@@ -0,0 +1,168 @@
+package seedgen
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+func testUTXOs(t *testing.T, pairs []*KeyPair, amounts []dcrutil.Amount) []UTXO {
+	utxos := make([]UTXO, len(pairs))
+	for i, pair := range pairs {
+		utxos[i] = UTXO{
+			Hash:   chainhash.Hash{byte(i + 1)},
+			Index:  uint32(i),
+			Amount: amounts[i],
+			Script: payToPair(t, pair),
+		}
+	}
+	return utxos
+}
+
+func TestBuildSweep(t *testing.T) {
+	one := testKeyPair(t, Testnet3, privateKeyOne)
+	two := testKeyPair(t, Testnet3, privateKeyTwo)
+
+	tests := []struct {
+		name    string
+		pairs   []*KeyPair
+		amounts []dcrutil.Amount
+		feeRate string
+	}{
+		{"one input", []*KeyPair{one}, []dcrutil.Amount{1e8}, DefaultSweepFeeRate},
+		{"two inputs", []*KeyPair{one, two}, []dcrutil.Amount{1e8, 5e7}, DefaultSweepFeeRate},
+		{"odd fee rate", []*KeyPair{one, two, one}, []dcrutil.Amount{1e6, 1e6, 1e6}, "0.00012345"},
+	}
+	for _, test := range tests {
+		utxos := testUTXOs(t, test.pairs, test.amounts)
+		sweep, err := BuildSweep(utxos, two.Address, test.feeRate)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		feePerKB, _ := parseAmount(test.feeRate)
+		wantFee := (feePerKB*dcrutil.Amount(sweep.EstimatedSize) + 999) / 1000
+		if sweep.Fee != wantFee {
+			t.Errorf("%s: fee: got %d, want %d", test.name, sweep.Fee, wantFee)
+		}
+		if sweep.Fee+sweep.OutputAmount != sweep.InputAmount {
+			t.Errorf("%s: fee %d and output %d don't add up to the input %d", test.name,
+				sweep.Fee, sweep.OutputAmount, sweep.InputAmount)
+		}
+
+		signed, err := sweep.Sign([]string{one.WIF(), two.WIF()})
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		prevOutputs := make([]PrevOutput, len(utxos))
+		for i, utxo := range utxos {
+			prevOutputs[i] = PrevOutput{Script: utxo.Script, Amount: utxo.Amount}
+		}
+		if valid := verifyInputs(t, signed.Hex, prevOutputs); valid != len(utxos) {
+			t.Errorf("%s: %d of %d inputs pass script verification", test.name, valid, len(utxos))
+		}
+
+		// the estimate is the worst case, signatures may come out a
+		// couple of bytes shorter
+		size := len(signed.Hex) / 2
+		if size > sweep.EstimatedSize || size < sweep.EstimatedSize-2*len(utxos) {
+			t.Errorf("%s: estimated %d bytes, the signed transaction is %d", test.name, sweep.EstimatedSize, size)
+		}
+		if int64(sweep.Fee)*1000 < int64(feePerKB)*int64(size) {
+			t.Errorf("%s: fee %d is below %s DCR/kB for %d bytes", test.name, sweep.Fee, test.feeRate, size)
+		}
+	}
+}
+
+func TestBuildSweepErrors(t *testing.T) {
+	one := testKeyPair(t, Testnet3, privateKeyOne)
+	utxos := testUTXOs(t, []*KeyPair{one}, []dcrutil.Amount{1e8})
+
+	tests := []struct {
+		name        string
+		utxos       []UTXO
+		destination string
+		feeRate     string
+		kind        error
+	}{
+		{"no outputs", nil, one.Address, DefaultSweepFeeRate, ErrInvalidInput},
+		{"unknown destination", utxos, "1BoatSLRHtKNngkdXEeobR76b53LETtpyT", DefaultSweepFeeRate, ErrUnknownNetwork},
+		{"invalid destination", utxos, one.Address[:len(one.Address)-1] + "y", DefaultSweepFeeRate, ErrInvalidAddress},
+		{"invalid fee rate", utxos, one.Address, "fast", ErrInvalidInput},
+		{"fee above amount", testUTXOs(t, []*KeyPair{one}, []dcrutil.Amount{1000}), one.Address, DefaultSweepFeeRate, ErrInvalidInput},
+		{"dust output", testUTXOs(t, []*KeyPair{one}, []dcrutil.Amount{7000}), one.Address, DefaultSweepFeeRate, ErrInvalidInput},
+	}
+	for _, test := range tests {
+		_, err := BuildSweep(test.utxos, test.destination, test.feeRate)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.kind)
+		}
+	}
+}
+
+func TestSweepSignErrors(t *testing.T) {
+	one := testKeyPair(t, Testnet3, privateKeyOne)
+	two := testKeyPair(t, Testnet3, privateKeyTwo)
+	mainnet := testKeyPair(t, Mainnet, privateKeyOne)
+
+	sweep, err := BuildSweep(testUTXOs(t, []*KeyPair{one, two}, []dcrutil.Amount{1e8, 1e8}), one.Address, DefaultSweepFeeRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = sweep.Sign([]string{one.WIF()})
+	if !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("missing key: got %v, want ErrInvalidPrivateKey", err)
+	}
+	_, err = sweep.Sign([]string{mainnet.WIF()})
+	if !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("other network: got %v, want ErrUnknownNetwork", err)
+	}
+}
+
+func TestIsDustAmount(t *testing.T) {
+	// a pay-to-pubkey-hash output costs 201 bytes to create and spend, so
+	// it is dust below 3 * 201 bytes at 10 atoms per byte
+	const p2pkhScriptSize = 25
+	if !isDustAmount(6029, p2pkhScriptSize) {
+		t.Error("6029 atoms is not dust")
+	}
+	if isDustAmount(6030, p2pkhScriptSize) {
+		t.Error("6030 atoms is dust")
+	}
+}
+
+func TestParseUTXOs(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	text := "txid,vout,amount,script\n" + hash + ", 1, 0.5, 76a914\n"
+
+	utxos, err := ParseUTXOs(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(utxos) != 1 {
+		t.Fatalf("got %d outputs, want 1", len(utxos))
+	}
+	utxo := utxos[0]
+	if utxo.Hash.String() != hash || utxo.Index != 1 || utxo.Amount != 5e7 || hex.EncodeToString(utxo.Script) != "76a914" {
+		t.Errorf("got %+v", utxo)
+	}
+
+	invalid := []string{
+		"zz,1,0.5,76a914",
+		hash + ",-1,0.5,76a914",
+		hash + ",1,half,76a914",
+		hash + ",1,0.5,zz",
+		hash + ",1,0.5",
+	}
+	for _, text := range invalid {
+		_, err := ParseUTXOs(strings.NewReader(text))
+		if !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%q: got %v, want ErrInvalidInput", text, err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package i18n
+
+var spanish = &Locale{
+	Tag:    "es",
+	Name:   "Español",
+	plural: oneOther,
+	messages: map[string]string{
+		// navigation
+		"Generate Seed":    "Generar semilla",
+		"Generate Address": "Generar dirección",
+		"Sign Message":     "Firmar mensaje",
+		"Sign Transaction": "Firmar transacción",
+		"Sweep":            "Barrer",
+		"Inspect":          "Inspeccionar",
+		"Decrypt Export":   "Descifrar exportación",
+		"Exports":          "Exportaciones",
+		"Settings":         "Ajustes",
+
+		"Could not check whether this machine is online: %s":                                                         "No se pudo comprobar si este equipo está conectado: %s",
+		"This machine is online (%s). Generating is disabled until it is disconnected from all networks.":            "Este equipo está conectado (%s). La generación está desactivada hasta que se desconecte de todas las redes.",
+		"This machine is online (%s). Disconnect it from all networks before generating secrets you intend to keep.": "Este equipo está conectado (%s). Desconéctelo de todas las redes antes de generar secretos que quiera conservar.",
+
+		// common
+		"Address":                            "Dirección",
+		"Addresses":                          "Direcciones",
+		"Address type":                       "Tipo de dirección",
+		"Back":                               "Atrás",
+		"Cancel":                             "Cancelar",
+		"Confirm":                            "Confirmar",
+		"Delete":                             "Eliminar",
+		"Derived address":                    "Dirección derivada",
+		"Export":                             "Exportar",
+		"Generate":                           "Generar",
+		"Hash160":                            "Hash160",
+		"Hide All":                           "Ocultar todo",
+		"Hold to Reveal":                     "Mantener para mostrar",
+		"Message":                            "Mensaje",
+		"Network":                            "Red",
+		"Preview":                            "Vista previa",
+		"Private Key":                        "Clave privada",
+		"Private Keys":                       "Claves privadas",
+		"Public Key":                         "Clave pública",
+		"Public key":                         "Clave pública",
+		"Refresh":                            "Actualizar",
+		"Regenerate":                         "Regenerar",
+		"Reveal":                             "Mostrar",
+		"Review":                             "Revisar",
+		"Show All":                           "Mostrar todo",
+		"Sign":                               "Firmar",
+		"Signature":                          "Firma",
+		"Signature Type":                     "Tipo de firma",
+		"Signature type":                     "Tipo de firma",
+		"Type":                               "Tipo",
+		"Verify":                             "Verificar",
+		"copied":                             "copiado",
+		"Exporting data...":                  "Exportando datos...",
+		"error copying to the clipboard: %s": "error al copiar al portapapeles: %s",
+
+		// table
+		"Filter":                   "Filtrar",
+		"No rows match the filter": "Ninguna fila coincide con el filtro",
+
+		// seed
+		"Seed Words":        "Palabras de la semilla",
+		"Seed Hex":          "Semilla en hexadecimal",
+		"Verify Seed Words": "Verificar palabras de la semilla",
+		"Regenerate seed?":  "¿Regenerar la semilla?",
+		"Invalid verification words. Please check the words and try again": "Palabras de verificación no válidas. Revise las palabras e inténtelo de nuevo",
+		"Verification successfull": "Verificación correcta",
+		"Regenerating discards this seed, it can't be shown again. Make sure it was written down or isn't needed.": "Regenerar descarta esta semilla, no se podrá volver a mostrar. Asegúrese de haberla anotado o de que no la necesita.",
+
+		// BIP39 mnemonic
+		"BIP39 Mnemonic":                    "Mnemónico BIP39",
+		"BIP39 Mnemonic (hardware wallets)": "Mnemónico BIP39 (monederos hardware)",
+		"This is not a dcrwallet seed. BIP39 mnemonics are for hardware wallets, dcrwallet and Decrediton can't restore them. Use the Generate Seed tab for dcrwallet.": "Esto no es una semilla de dcrwallet. Los mnemónicos BIP39 son para monederos hardware, dcrwallet y Decrediton no pueden restaurarlos. Use la pestaña Generar semilla para dcrwallet.",
+		"%d words":             "%d palabras",
+		"Regenerate mnemonic?": "¿Regenerar el mnemónico?",
+		"Regenerating discards this mnemonic, it can't be shown again. Make sure it was written down or isn't needed.": "Regenerar descarta este mnemónico, no se podrá volver a mostrar. Asegúrese de haberlo anotado o de que no lo necesita.",
+		"Passphrase (optional)": "Frase de contraseña (opcional)",
+		"The passphrase is part of the wallet, the same words with another passphrase restore a different wallet.": "La frase de contraseña forma parte del monedero, las mismas palabras con otra frase restauran un monedero distinto.",
+		"Mnemonic Words":       "Palabras del mnemónico",
+		"Account Keys":         "Claves de la cuenta",
+		"Derive Keys":          "Derivar claves",
+		"Derivation path":      "Ruta de derivación",
+		"Extended public key":  "Clave pública extendida",
+		"Extended private key": "Clave privada extendida",
+		"First address":        "Primera dirección",
+
+		// address
+		"How many?": "¿Cuántas?",
+		"Recipient public key (optional, encrypts export)":                  "Clave pública del destinatario (opcional, cifra la exportación)",
+		"Exports are set to be encrypted, type in the recipient public key": "Las exportaciones se cifran, escriba la clave pública del destinatario",
+		"Exported data to %s":                         "Datos exportados a %s",
+		"error exporting data: %s":                    "error al exportar los datos: %s",
+		"Please type in the required number of pairs": "Escriba el número de pares necesario",
+		"Please specify a valid number to generate":   "Indique un número válido para generar",
+
+		// sign message
+		"Private key (WIF)":                                       "Clave privada (WIF)",
+		"Type in a key":                                           "Escribir una clave",
+		"Signing with the generated key of %s":                    "Firmando con la clave generada de %s",
+		"Select a generated address to sign with its key":         "Seleccione una dirección generada para firmar con su clave",
+		"Please type in or select the private key to sign with":   "Escriba o seleccione la clave privada con la que firmar",
+		"Please type in the address and the signature to verify":  "Escriba la dirección y la firma que verificar",
+		"The signature is valid for this address and message":     "La firma es válida para esta dirección y mensaje",
+		"The signature is not valid for this address and message": "La firma no es válida para esta dirección y mensaje",
+		"error signing message: %s":                               "error al firmar el mensaje: %s",
+		"error verifying message: %s":                             "error al verificar el mensaje: %s",
+
+		// sign transaction and sweep
+		"Load Keys":                        "Cargar claves",
+		"Build":                            "Construir",
+		"Path to a dcrseedgen export":      "Ruta a una exportación de dcrseedgen",
+		"Private keys (WIF), one per line": "Claves privadas (WIF), una por línea",
+		"Unsigned transaction (hex)":       "Transacción sin firmar (hex)",
+		"Previous outputs, one per input: <script hex> <amount in DCR>": "Salidas anteriores, una por entrada: <script hex> <cantidad en DCR>",
+		"Signed Transaction": "Transacción firmada",
+		"Signed %d of %d inputs, the remaining inputs need other keys": "Firmadas %d de %d entradas, las demás entradas necesitan otras claves",
+		"Signed all %d inputs": "Firmadas las %d entradas",
+		"Please type in the path to the export to load keys from": "Escriba la ruta de la exportación de la que cargar las claves",
+		"Please type in the unsigned transaction":                 "Escriba la transacción sin firmar",
+		"transaction is too large to show as a QR code":           "la transacción es demasiado grande para mostrarla como código QR",
+		"error loading keys: %s":                                  "error al cargar las claves: %s",
+		"error reading previous outputs: %s":                      "error al leer las salidas anteriores: %s",
+		"error signing transaction: %s":                           "error al firmar la transacción: %s",
+		"Sweep Paper Key":                                         "Barrer clave en papel",
+		"Path to UTXO file (txid,vout,amount,script)":             "Ruta al archivo de UTXO (txid,vout,cantidad,script)",
+		"Destination address":                                     "Dirección de destino",
+		"Fee rate (DCR/kB)":                                       "Comisión (DCR/kB)",
+		"Destination":                                             "Destino",
+		"Total input":                                             "Entrada total",
+		"Fee":                                                     "Comisión",
+		"Output amount":                                           "Cantidad de salida",
+		"Estimated size":                                          "Tamaño estimado",
+		"Please type in the path to the UTXO file":                "Escriba la ruta al archivo de UTXO",
+		"error reading UTXO file: %s":                             "error al leer el archivo de UTXO: %s",
+		"error building sweep: %s":                                "error al construir el barrido: %s",
+		"error signing sweep: %s":                                 "error al firmar el barrido: %s",
+
+		// inspector
+		"Inspect Key or Address":                                "Inspeccionar clave o dirección",
+		"Private key (WIF) or address":                          "Clave privada (WIF) o dirección",
+		"Address expected for the private key (optional)":       "Dirección esperada para la clave privada (opcional)",
+		"Please type in a private key or an address to inspect": "Escriba una clave privada o una dirección que inspeccionar",
+		"The private key matches the address":                   "La clave privada corresponde a la dirección",
+		"The private key does not match the address":            "La clave privada no corresponde a la dirección",
+		"Pay-to-pubkey-hash":                                    "Pago a hash de clave pública",
+		"Pay-to-script-hash":                                    "Pago a hash de script",
+		"Pay-to-pubkey":                                         "Pago a clave pública",
+		"unknown":                                               "desconocido",
+
+		// decrypt
+		"Decrypt":                     "Descifrar",
+		"Path to encrypted export":    "Ruta a la exportación cifrada",
+		"Recipient private key (WIF)": "Clave privada del destinatario (WIF)",
+		"Please type in the path to the encrypted export": "Escriba la ruta a la exportación cifrada",
+		"Please type in the private key of the recipient": "Escriba la clave privada del destinatario",
+		"error decrypting export: %s":                     "error al descifrar la exportación: %s",
+
+		// exports
+		"File":                           "Archivo",
+		"Size":                           "Tamaño",
+		"Exported":                       "Exportado",
+		"Rows":                           "Filas",
+		"No exports found":               "No se encontraron exportaciones",
+		"Securely deleted %s":            "%s eliminado de forma segura",
+		"error opening file manager: %s": "error al abrir el gestor de archivos: %s",
+		"error previewing export: %s":    "error al previsualizar la exportación: %s",
+		"error deleting export: %s":      "error al eliminar la exportación: %s",
+
+		// settings
+		"Saved to %s":         "Guardado en %s",
+		"Default network":     "Red predeterminada",
+		"Seed length":         "Longitud de la semilla",
+		"%d words (%d bytes)": "%d palabras (%d bytes)",
+		"Seed verification":   "Verificación de la semilla",
+		"Every word":          "Todas las palabras",
+		"Export format":       "Formato de exportación",
+		"Encrypted CSV":       "CSV cifrado",
+		"Theme":               "Tema",
+		"Light":               "Claro",
+		"Dark":                "Oscuro",
+		"High contrast":       "Alto contraste",
+		"Language":            "Idioma",
+		"Page transitions":    "Transiciones entre páginas",
+		"Animated":            "Animadas",
+		"Off":                 "Desactivadas",
+		"System language":     "Idioma del sistema",
+		"Recipient public key for encrypted exports":             "Clave pública del destinatario para exportaciones cifradas",
+		"Export location (default %s)":                           "Ubicación de las exportaciones (predeterminada %s)",
+		"Clipboard timeout, e.g. 30s":                            "Tiempo en el portapapeles, p. ej. 30s",
+		"Save":                                                   "Guardar",
+		"Restore Defaults":                                       "Restaurar valores predeterminados",
+		"Defaults restored, save to keep them":                   "Valores predeterminados restaurados, guarde para conservarlos",
+		"Settings not saved: %s":                                 "Ajustes no guardados: %s",
+		"Settings saved":                                         "Ajustes guardados",
+		"choose a seed length":                                   "elija una longitud de semilla",
+		"clipboard timeout must be a duration such as 30s or 1m": "el tiempo en el portapapeles debe ser una duración como 30s o 1m",
+	},
+	plurals: map[string][]string{
+		"%d byte":                               {"%d byte", "%d bytes"},
+		"%d random word":                        {"%d palabra al azar", "%d palabras al azar"},
+		"Loaded %d private key from the export": {"Cargada %d clave privada de la exportación", "Cargadas %d claves privadas de la exportación"},
+		"copied, clears in %ds":                 {"copiado, se borra en %ds", "copiado, se borra en %ds"},
+	},
+}
@@ -0,0 +1,132 @@
+// Package i18n translates the text shown in the user interface.
+//
+// Messages are looked up by their English text, so code reads the same as
+// before translating and anything missing from a catalog is shown in English:
+//
+//	label := th.H5(i18n.T("Seed Words"))
+//	message := i18n.N("Loaded %d private key", "Loaded %d private keys", len(keys), len(keys))
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Locale is a language the user interface can be shown in.
+type Locale struct {
+	// Tag is the language tag, e.g. "es".
+	Tag string
+	// Name is the name of the language in that language.
+	Name string
+
+	// plural returns which plural form is used for n.
+	plural func(n int) int
+	// messages maps English messages to their translation.
+	messages map[string]string
+	// plurals maps the English singular of a message to its translated
+	// plural forms, in the order plural picks them.
+	plurals map[string][]string
+}
+
+// oneOther is the plural rule of languages that use the singular only for 1.
+func oneOther(n int) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// English is the language the messages are written in, it needs no catalog.
+var English = &Locale{
+	Tag:    "en",
+	Name:   "English",
+	plural: oneOther,
+}
+
+// Locales lists every language the user interface can be shown in.
+var Locales = []*Locale{English, spanish}
+
+var (
+	currentMu sync.RWMutex
+	current   = English
+)
+
+// SetLocale switches to the locale matching tag, e.g. "es" or "es_AR.UTF-8".
+// Tags without a matching locale switch to English. The locale in use is
+// returned.
+func SetLocale(tag string) *Locale {
+	locale := Find(tag)
+
+	currentMu.Lock()
+	current = locale
+	currentMu.Unlock()
+	return locale
+}
+
+// Current returns the locale in use.
+func Current() *Locale {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	return current
+}
+
+// Find returns the locale matching tag, ignoring the region and encoding, or
+// English if there is none.
+func Find(tag string) *Locale {
+	tag = strings.ToLower(tag)
+	if i := strings.IndexAny(tag, "_-."); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, locale := range Locales {
+		if locale.Tag == tag {
+			return locale
+		}
+	}
+	return English
+}
+
+// SystemTag returns the language tag set in the environment, the same way
+// gettext looks it up.
+func SystemTag() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if tag := os.Getenv(name); tag != "" {
+			return tag
+		}
+	}
+	return English.Tag
+}
+
+// T translates message into the current locale. If args are given the
+// translation is formatted with them like fmt.Sprintf.
+func T(message string, args ...interface{}) string {
+	locale := Current()
+	if translation, ok := locale.messages[message]; ok {
+		message = translation
+	}
+	return format(message, args)
+}
+
+// N translates the singular or plural of a message, whichever the current
+// locale uses for n, and formats it with args like fmt.Sprintf.
+func N(singular, plural string, n int, args ...interface{}) string {
+	locale := Current()
+	form := locale.plural(n)
+
+	message := plural
+	if form == 0 {
+		message = singular
+	}
+	if forms, ok := locale.plurals[singular]; ok && form < len(forms) {
+		message = forms[form]
+	}
+	return format(message, args)
+}
+
+func format(message string, args []interface{}) string {
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
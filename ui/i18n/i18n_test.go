@@ -0,0 +1,157 @@
+package i18n
+
+import (
+	"os"
+	"testing"
+)
+
+// useLocale switches to locale until the returned function is called.
+func useLocale(locale *Locale) func() {
+	currentMu.Lock()
+	previous := current
+	current = locale
+	currentMu.Unlock()
+	return func() {
+		currentMu.Lock()
+		current = previous
+		currentMu.Unlock()
+	}
+}
+
+// slavic has the three plural forms of languages such as Russian, for
+// checking that N uses whichever form the rule picks.
+var slavic = &Locale{
+	Tag: "xx",
+	plural: func(n int) int {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+			return 1
+		default:
+			return 2
+		}
+	},
+	plurals: map[string][]string{
+		"%d file": {"%d one", "%d few", "%d many"},
+		"%d key":  {"%d one", "%d few"},
+	},
+}
+
+func TestN(t *testing.T) {
+	tests := []struct {
+		locale   *Locale
+		singular string
+		plural   string
+		n        int
+		want     string
+	}{
+		{English, "%d file", "%d files", 0, "0 files"},
+		{English, "%d file", "%d files", 1, "1 file"},
+		{English, "%d file", "%d files", 2, "2 files"},
+		{spanish, "%d random word", "%d random words", 1, "1 palabra al azar"},
+		{spanish, "%d random word", "%d random words", 0, "0 palabras al azar"},
+		{spanish, "%d random word", "%d random words", 5, "5 palabras al azar"},
+		{spanish, "%d untranslated", "%d untranslated ones", 1, "1 untranslated"},
+		{spanish, "%d untranslated", "%d untranslated ones", 3, "3 untranslated ones"},
+		{slavic, "%d file", "%d files", 1, "1 one"},
+		{slavic, "%d file", "%d files", 21, "21 one"},
+		{slavic, "%d file", "%d files", 3, "3 few"},
+		{slavic, "%d file", "%d files", 11, "11 many"},
+		{slavic, "%d file", "%d files", 25, "25 many"},
+		// a catalog missing a form falls back to the English plural
+		{slavic, "%d key", "%d keys", 5, "5 keys"},
+	}
+
+	for _, test := range tests {
+		restore := useLocale(test.locale)
+		got := N(test.singular, test.plural, test.n, test.n)
+		restore()
+		if got != test.want {
+			t.Errorf("%s %d: got %q, want %q", test.locale.Tag, test.n, got, test.want)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	defer useLocale(spanish)()
+
+	if got, want := T("Settings"), "Ajustes"; got != want {
+		t.Errorf("translated: got %q, want %q", got, want)
+	}
+	if got, want := T("Not in the catalog"), "Not in the catalog"; got != want {
+		t.Errorf("missing: got %q, want %q", got, want)
+	}
+	if got, want := T("Exported data to %s", "/tmp"), "Datos exportados a /tmp"; got != want {
+		t.Errorf("formatted: got %q, want %q", got, want)
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want *Locale
+	}{
+		{"en", English},
+		{"es", spanish},
+		{"ES", spanish},
+		{"es_AR", spanish},
+		{"es-MX", spanish},
+		{"es_ES.UTF-8", spanish},
+		{"es.UTF-8", spanish},
+		{"fr_FR.UTF-8", English},
+		{"C", English},
+		{"", English},
+		{"esperanto", English},
+	}
+
+	for _, test := range tests {
+		if got := Find(test.tag); got != test.want {
+			t.Errorf("%q: got %s, want %s", test.tag, got.Tag, test.want.Tag)
+		}
+	}
+}
+
+func TestSystemTag(t *testing.T) {
+	names := []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+	saved := make(map[string]*string)
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			saved[name] = &value
+		} else {
+			saved[name] = nil
+		}
+	}
+	defer func() {
+		for name, value := range saved {
+			if value == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *value)
+			}
+		}
+	}()
+
+	tests := []struct {
+		env  map[string]string
+		want string
+	}{
+		{map[string]string{}, "en"},
+		{map[string]string{"LANG": "es_ES.UTF-8"}, "es_ES.UTF-8"},
+		{map[string]string{"LANG": "en_US.UTF-8", "LC_MESSAGES": "es_AR"}, "es_AR"},
+		{map[string]string{"LANG": "en_US", "LC_MESSAGES": "en_GB", "LC_ALL": "es"}, "es"},
+		{map[string]string{"LANG": "es", "LC_ALL": ""}, "es"},
+	}
+
+	for _, test := range tests {
+		for _, name := range names {
+			os.Unsetenv(name)
+		}
+		for name, value := range test.env {
+			os.Setenv(name, value)
+		}
+		if got := SystemTag(); got != test.want {
+			t.Errorf("%v: got %q, want %q", test.env, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Package notify shows short-lived notifications, toasts, stacked over
+// whichever page is open.
+package notify
+
+import (
+	"image"
+	"image/color"
+	"sync"
+	"time"
+
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Severity says how important a notification is, it picks the color of the
+// toast and how long it stays up.
+type Severity int
+
+const (
+	Info Severity = iota
+	Success
+	Warning
+	Error
+)
+
+const (
+	// maxToasts is how many toasts are shown at once, newer ones wait until
+	// one of those is taken down.
+	maxToasts = 4
+	// maxQueued is how many toasts can wait to be shown, the oldest waiting
+	// ones are dropped to make room for new ones.
+	maxQueued = 16
+
+	toastWidth = 360
+)
+
+// timeout returns how long a toast of severity s stays up, problems are
+// kept up for longer so that there is time to read them.
+func (s Severity) timeout() time.Duration {
+	switch s {
+	case Warning, Error:
+		return 10 * time.Second
+	default:
+		return 5 * time.Second
+	}
+}
+
+type toast struct {
+	severity Severity
+	message  string
+	timer    *time.Timer
+	close    widget.Clickable
+}
+
+// Notifier queues notifications and draws them as toasts. It can be used
+// from any goroutine, the window is redrawn through invalidate whenever a
+// toast is shown or taken down.
+type Notifier struct {
+	mu         sync.Mutex
+	toasts     []*toast
+	queued     []*toast
+	invalidate func()
+	afterFunc  func(time.Duration, func()) *time.Timer
+	closeIcon  *theme.Icon
+}
+
+// New returns a notifier that calls invalidate to have the window redrawn.
+func New(invalidate func()) *Notifier {
+	return &Notifier{
+		invalidate: invalidate,
+		afterFunc:  time.AfterFunc,
+		closeIcon:  theme.MustIcon(theme.NewIcon(icons.NavigationClose)),
+	}
+}
+
+// Notify shows message with the given severity until it expires or is
+// closed. If maxToasts are already up it waits for one of them to go.
+func (n *Notifier) Notify(severity Severity, message string) {
+	t := &toast{
+		severity: severity,
+		message:  message,
+	}
+
+	n.mu.Lock()
+	shown := len(n.toasts) < maxToasts
+	if shown {
+		n.show(t)
+	} else {
+		if len(n.queued) == maxQueued {
+			n.queued = n.queued[1:]
+		}
+		n.queued = append(n.queued, t)
+	}
+	n.mu.Unlock()
+
+	if shown {
+		n.invalidate()
+	}
+}
+
+// show puts t up and starts its timeout, n.mu must be held.
+func (n *Notifier) show(t *toast) {
+	n.toasts = append(n.toasts, t)
+	t.timer = n.afterFunc(t.severity.timeout(), func() {
+		n.remove(t)
+	})
+}
+
+func (n *Notifier) Info(message string) {
+	n.Notify(Info, message)
+}
+
+func (n *Notifier) Success(message string) {
+	n.Notify(Success, message)
+}
+
+func (n *Notifier) Warning(message string) {
+	n.Notify(Warning, message)
+}
+
+func (n *Notifier) Error(message string) {
+	n.Notify(Error, message)
+}
+
+// Clear takes every toast down, including the ones waiting to be shown.
+func (n *Notifier) Clear() {
+	n.mu.Lock()
+	for _, t := range n.toasts {
+		t.timer.Stop()
+	}
+	n.toasts = nil
+	n.queued = nil
+	n.mu.Unlock()
+
+	n.invalidate()
+}
+
+// remove takes t down if it is still up and shows the oldest waiting toast
+// in its place.
+func (n *Notifier) remove(t *toast) {
+	n.mu.Lock()
+	removed := false
+	for i := range n.toasts {
+		if n.toasts[i] == t {
+			t.timer.Stop()
+			n.toasts = append(n.toasts[:i], n.toasts[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	if removed && len(n.queued) > 0 {
+		n.show(n.queued[0])
+		n.queued = n.queued[1:]
+	}
+	n.mu.Unlock()
+
+	if removed {
+		n.invalidate()
+	}
+}
+
+// Layout stacks the toasts in the bottom right corner of the area it is
+// given, the newest at the bottom.
+func (n *Notifier) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	n.mu.Lock()
+	toasts := make([]*toast, len(n.toasts))
+	copy(toasts, n.toasts)
+	n.mu.Unlock()
+
+	for _, t := range toasts {
+		for t.close.Clicked() {
+			n.remove(t)
+		}
+	}
+
+	gtx.Constraints.Min = gtx.Constraints.Max
+	return layout.SE.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			width := gtx.Px(unit.Dp(toastWidth))
+			if width > gtx.Constraints.Max.X {
+				width = gtx.Constraints.Max.X
+			}
+			gtx.Constraints.Min.X = width
+			gtx.Constraints.Max.X = width
+
+			children := make([]layout.FlexChild, len(toasts))
+			for i, t := range toasts {
+				t := t
+				children[i] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Top: unit.Dp(8)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return n.layoutToast(gtx, th, t)
+					})
+				})
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		})
+	})
+}
+
+func (n *Notifier) layoutToast(gtx layout.Context, th *theme.Theme, t *toast) layout.Dimensions {
+	background := severityColor(th, t.severity)
+
+	closeButton := th.IconButton(n.closeIcon, &t.close)
+	closeButton.Background = background
+	closeButton.Color = th.Color.InvText
+	closeButton.Size = unit.Dp(20)
+	closeButton.Padding = unit.Dp(2)
+
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			// clicks on a toast don't reach the page below it
+			pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+			pointer.InputOp{Tag: t}.Add(gtx.Ops)
+			return theme.Fill(gtx, background)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return layout.UniformInset(unit.Dp(10)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+						label := th.Body2(t.message)
+						label.Color = th.Color.InvText
+						return label.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return layout.Inset{Left: unit.Dp(10)}.Layout(gtx, closeButton.Layout)
+					}),
+				)
+			})
+		}),
+	)
+}
+
+func severityColor(th *theme.Theme, severity Severity) color.RGBA {
+	switch severity {
+	case Success:
+		return th.Color.Success
+	case Warning:
+		return th.Color.Warning
+	case Error:
+		return th.Color.Danger
+	default:
+		return th.Color.Primary
+	}
+}
@@ -0,0 +1,163 @@
+package notify
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testNotifier returns a notifier without an icon to draw toasts with. The
+// toasts expire when expire is called with their message instead of after
+// their timeout.
+func testNotifier(invalidated *int32) (notifier *Notifier, expire func(message string)) {
+	var mu sync.Mutex
+	timeouts := make(map[string]func())
+	notifier = &Notifier{
+		invalidate: func() { atomic.AddInt32(invalidated, 1) },
+	}
+	notifier.afterFunc = func(_ time.Duration, f func()) *time.Timer {
+		mu.Lock()
+		timeouts[notifier.toasts[len(notifier.toasts)-1].message] = f
+		mu.Unlock()
+		return time.AfterFunc(time.Hour, func() {})
+	}
+	expire = func(message string) {
+		mu.Lock()
+		f := timeouts[message]
+		mu.Unlock()
+		f()
+	}
+	return notifier, expire
+}
+
+// messages returns the messages of the toasts shown and of the ones waiting
+// to be shown.
+func (n *Notifier) messages() (shown, queued []string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, t := range n.toasts {
+		shown = append(shown, t.message)
+	}
+	for _, t := range n.queued {
+		queued = append(queued, t.message)
+	}
+	return shown, queued
+}
+
+func numbered(from, to int) []string {
+	var messages []string
+	for i := from; i < to; i++ {
+		messages = append(messages, fmt.Sprint(i))
+	}
+	return messages
+}
+
+func TestNotifierQueue(t *testing.T) {
+	var invalidated int32
+	notifier, expire := testNotifier(&invalidated)
+
+	const sent = maxToasts + maxQueued + 2
+	for _, message := range numbered(0, sent) {
+		notifier.Info(message)
+	}
+	shown, queued := notifier.messages()
+	if want := numbered(0, maxToasts); !reflect.DeepEqual(shown, want) {
+		t.Errorf("shown: got %v, want %v", shown, want)
+	}
+	// the oldest waiting toasts made room for the last two
+	if want := numbered(maxToasts+2, sent); !reflect.DeepEqual(queued, want) {
+		t.Errorf("queued: got %v, want %v", queued, want)
+	}
+	if invalidated != maxToasts {
+		t.Errorf("invalidated %d times, want once per toast shown", invalidated)
+	}
+
+	expire("1")
+	shown, queued = notifier.messages()
+	want := append(numbered(0, 1), numbered(2, maxToasts)...)
+	want = append(want, fmt.Sprint(maxToasts+2))
+	if !reflect.DeepEqual(shown, want) {
+		t.Errorf("shown after expiry: got %v, want %v", shown, want)
+	}
+	if len(queued) != maxQueued-1 {
+		t.Errorf("queued after expiry: got %d, want %d", len(queued), maxQueued-1)
+	}
+	if invalidated != maxToasts+1 {
+		t.Errorf("invalidated %d times after expiry, want %d", invalidated, maxToasts+1)
+	}
+
+	// a toast that already expired doesn't take another one down
+	expire("1")
+	if again, _ := notifier.messages(); !reflect.DeepEqual(again, shown) {
+		t.Errorf("expired twice: got %v, want %v", again, shown)
+	}
+
+	notifier.Clear()
+	shown, queued = notifier.messages()
+	if len(shown) != 0 || len(queued) != 0 {
+		t.Errorf("cleared: got %v shown and %v queued", shown, queued)
+	}
+	if invalidated != maxToasts+2 {
+		t.Errorf("invalidated %d times after clearing, want %d", invalidated, maxToasts+2)
+	}
+}
+
+// TestNotifierConcurrent is meant to be run with -race.
+func TestNotifierConcurrent(t *testing.T) {
+	var invalidated int32
+	notifier := &Notifier{
+		invalidate: func() { atomic.AddInt32(&invalidated, 1) },
+		afterFunc: func(_ time.Duration, f func()) *time.Timer {
+			return time.AfterFunc(time.Millisecond, f)
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				notifier.Notify(Severity(j%4), fmt.Sprint(i, j))
+			}
+		}(i)
+	}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 20; j++ {
+			notifier.Clear()
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 200; j++ {
+			shown, queued := notifier.messages()
+			if len(shown) > maxToasts || len(queued) > maxQueued {
+				t.Errorf("got %d shown and %d queued, want at most %d and %d", len(shown), len(queued), maxToasts, maxQueued)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	// the toasts left expire one after the other
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		shown, queued := notifier.messages()
+		if len(shown) == 0 && len(queued) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("still %d shown and %d queued", len(shown), len(queued))
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if atomic.LoadInt32(&invalidated) == 0 {
+		t.Error("the window was never redrawn")
+	}
+}
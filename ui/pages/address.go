@@ -4,9 +4,9 @@ import (
 	"errors"
 	"image"
 	"strconv"
-	"time"
 
 	"gioui.org/f32"
+	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/paint"
@@ -14,174 +14,352 @@ import (
 	"gioui.org/widget"
 	"gioui.org/widget/material"
 
+	"github.com/atotto/clipboard"
 	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
 	"github.com/raedahgroup/dcrseedgen/ui/theme"
 	"golang.org/x/exp/shiny/materialdesign/icons"
 )
 
 const (
-	AddressPageID      = "AddressPage"
-	numRowWidth        = 0.03
-	addressRowWidth    = 0.4
-	privateKeyRowWidth = 0.57
+	AddressPageID         = "AddressPage"
+	numRowWidth           = 0.03
+	addressRowWidth       = 0.4
+	privateKeyRowWidth    = 0.57
+	publicKeyRowWidth     = 0.6
+	hash160RowWidth       = 0.4
+	signatureTypeRowWidth = 0.2
+	networkRowWidth       = 0.15
 )
 
+type addressColumn struct {
+	title string
+	width float32
+	value func(pair *seedgen.KeyPair) string
+
+	// secret columns are masked until revealed and are copied through the
+	// clipboard that clears itself again after the timeout.
+	secret bool
+
+	// visible toggles optional columns, it is nil for columns that are
+	// always shown.
+	visible *widget.Bool
+}
+
 type AddressPage struct {
+	pageHooks
+
 	theme                    *theme.Theme
-	generatedAddresses       []string
-	generatedPrivateKeys     []string
+	clipboard                *helper.SecretClipboard
+	config                   *helper.Config
+	generatedPairs           []*seedgen.KeyPair
+	keyReveals               []*theme.Reveal
+	reveal                   *theme.Reveal
+	revealControls           theme.RevealControls
 	numOfItemsEditorMaterial theme.Editor
 	numOfItemsEditorWidget   *widget.Editor
 	generateButtonMaterial   theme.Button
 	generateButtonWidget     *widget.Clickable
 	addressesLabel           material.LabelStyle
 	privateKeysLabel         material.LabelStyle
-	exportingDataLabel       material.LabelStyle
 
 	networkGroup         *widget.Enum
 	networkRadioMaterial []theme.RadioButton
 
+	columns                []addressColumn
+	columnCheckBoxMaterial []theme.CheckBox
+
 	exportIcon       theme.IconButton
 	exportIconWidget *widget.Clickable
 
-	message helper.Message
+	recipientKeyEditorMaterial theme.Editor
+	recipientKeyEditorWidget   *widget.Editor
+
+	notifier *notify.Notifier
 
+	// exportModal shows that an export is running. The export blocks, so it
+	// is only started once the modal has been drawn.
+	exportModal     *theme.Modal
+	exportRequested bool
 	isExportingData bool
 
-	list        *layout.List
-	addressList *layout.List
-	err         error
+	list  *layout.List
+	table *theme.Table
+	err   error
 }
 
-func NewAddressPage(th *theme.Theme) *AddressPage {
+// NewAddressPage returns the key pair generation page, the network selected
+// at first and the export format are taken from cfg.
+func NewAddressPage(th *theme.Theme, notifier *notify.Notifier, secretClipboard *helper.SecretClipboard, cfg *helper.Config) *AddressPage {
 	page := &AddressPage{
-		theme: th,
+		theme:     th,
+		notifier:  notifier,
+		clipboard: secretClipboard,
+		config:    cfg,
 	}
 
 	page.list = &layout.List{
 		Axis: layout.Vertical,
 	}
 
-	page.addressList = &layout.List{
-		Axis: layout.Vertical,
-	}
-
-	networks := []string{"Testnet3", "Mainnet", "Regnet"}
+	networks := []string{"Testnet3", "Mainnet", "Regnet", "Simnet"}
 
 	page.networkGroup = new(widget.Enum)
-	page.networkGroup.Value = networks[0]
+	page.networkGroup.Value = cfg.DefaultNetwork
 	page.networkRadioMaterial = make([]theme.RadioButton, len(networks))
 	for i := range networks {
 		page.networkRadioMaterial[i] = th.RadioButton(networks[i], networks[i], page.networkGroup)
 		page.networkRadioMaterial[i].Size = unit.Dp(20)
 	}
 
+	page.columns = []addressColumn{
+		{title: i18n.T("Address"), width: addressRowWidth, value: func(pair *seedgen.KeyPair) string { return pair.Address }},
+		{title: i18n.T("Private Key"), width: privateKeyRowWidth, value: func(pair *seedgen.KeyPair) string { return pair.WIF() }, secret: true},
+		{title: i18n.T("Public Key"), width: publicKeyRowWidth, value: func(pair *seedgen.KeyPair) string { return pair.PublicKey }, visible: new(widget.Bool)},
+		{title: i18n.T("Hash160"), width: hash160RowWidth, value: func(pair *seedgen.KeyPair) string { return pair.Hash160 }, visible: new(widget.Bool)},
+		{title: i18n.T("Signature Type"), width: signatureTypeRowWidth, value: func(pair *seedgen.KeyPair) string { return pair.SignatureType }, visible: new(widget.Bool)},
+		{title: i18n.T("Network"), width: networkRowWidth, value: func(pair *seedgen.KeyPair) string { return string(pair.Network) }, visible: new(widget.Bool)},
+	}
+	for _, column := range page.columns {
+		if column.visible != nil {
+			page.columnCheckBoxMaterial = append(page.columnCheckBoxMaterial, th.CheckBox(column.title, column.visible))
+		}
+	}
+	page.table = th.Table(page.tableColumns())
+	page.table.OnCopy = page.copyValue
+
+	page.reveal = theme.NewReveal(nil)
+	page.revealControls = th.RevealControls(page.reveal)
+
 	page.numOfItemsEditorWidget = &widget.Editor{
 		SingleLine: true,
 		Submit:     true,
 	}
-	page.numOfItemsEditorMaterial = th.Editor("How many?", page.numOfItemsEditorWidget)
+	page.numOfItemsEditorMaterial = th.Editor(i18n.T("How many?"), page.numOfItemsEditorWidget)
 	page.numOfItemsEditorWidget.SetText("1")
 
 	page.generateButtonWidget = new(widget.Clickable)
-	page.generateButtonMaterial = th.Button("Generate", page.generateButtonWidget)
+	page.generateButtonMaterial = th.Button(i18n.T("Generate"), page.generateButtonWidget)
 
-	page.addressesLabel = th.Body1("Addresses")
-	page.privateKeysLabel = th.Body1("Private Keys")
+	page.addressesLabel = th.Body1(i18n.T("Addresses"))
+	page.privateKeysLabel = th.Body1(i18n.T("Private Keys"))
 
 	page.exportIconWidget = new(widget.Clickable)
 	page.exportIcon = th.IconButton(theme.MustIcon(theme.NewIcon(icons.CommunicationImportExport)), page.exportIconWidget)
 	page.exportIcon.Size = unit.Dp(30)
 	page.exportIcon.Padding = unit.Dp(5)
-	page.exportIcon.Color = th.Color.Surface
+	page.exportIcon.Color = th.Color.InvText
 
-	page.isExportingData = false
-	page.exportingDataLabel = th.Caption("Exporting data...")
+	page.recipientKeyEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.recipientKeyEditorMaterial = th.Editor(i18n.T("Recipient public key (optional, encrypts export)"), page.recipientKeyEditorWidget)
+
+	page.exportModal = th.Modal(i18n.T("Export"), "", "")
 
 	return page
 }
 
-func (page *AddressPage) BeforeRender() {
-	page.resetMessage()
+func (page *AddressPage) OnEnter() {
 
-	page.generatedAddresses = nil
-	page.generatedPrivateKeys = nil
+	page.wipePairs()
+	page.reveal.Hide()
 
 	page.numOfItemsEditorWidget.SetText("1")
+	page.networkGroup.Value = page.config.DefaultNetwork
+	if page.config.ExportFormat == helper.ExportFormatEncryptedCSV {
+		page.recipientKeyEditorWidget.SetText(page.config.ExportRecipientKey)
+	} else {
+		page.recipientKeyEditorWidget.SetText("")
+	}
 }
 
-func (page *AddressPage) resetMessage() {
-	page.message.Message = ""
-	page.message.Variant = ""
+// OnLeave drops an export that was asked for but not started yet.
+func (page *AddressPage) OnLeave() {
+	page.exportRequested = false
+	page.isExportingData = false
+	page.exportModal.Hide()
 }
 
 func (page *AddressPage) handleEvents() {
+	if page.isExportingData {
+		page.exportCSV()
+		page.isExportingData = false
+		page.exportModal.Hide()
+	}
+
 	for page.generateButtonWidget.Clicked() {
-		page.resetMessage()
-		page.generatePairs(page.networkGroup.Value)
+		page.generate()
+	}
+
+	if submitted(page.numOfItemsEditorWidget) {
+		page.generate()
 	}
 
 	for page.exportIconWidget.Clicked() {
-		page.resetMessage()
-		page.exportCSV()
+		page.export()
 	}
 
-	if page.message.Message != "" && page.message.Variant == "success" {
-		time.AfterFunc(time.Second*5, func() {
-			page.message.Message = ""
-		})
+	for i, column := range page.columns {
+		page.table.SetHidden(i, column.visible != nil && !column.visible.Value)
 	}
 }
 
-func (page *AddressPage) exportCSV() {
-	page.isExportingData = true
+// tableColumns returns the columns of the results table. Secret values are
+// left out of sorting and filtering so that they are only turned into
+// strings while revealed or copied.
+func (page *AddressPage) tableColumns() []theme.TableColumn {
+	columns := make([]theme.TableColumn, len(page.columns))
+	for i, column := range page.columns {
+		column := column
+		columns[i] = theme.TableColumn{
+			Title: column.title,
+			Width: column.width,
+			Copy:  true,
+		}
+		if column.secret {
+			columns[i].Cell = func(gtx layout.Context, row int) layout.Dimensions {
+				return page.renderSecretValue(gtx, row, func() string { return column.value(page.generatedPairs[row]) })
+			}
+			continue
+		}
+		columns[i].Value = func(row int) string {
+			return column.value(page.generatedPairs[row])
+		}
+	}
+	return columns
+}
+
+// copyValue copies the value in row and column of the table, secrets go
+// through the clipboard that clears itself again.
+func (page *AddressPage) copyValue(row, column int) {
+	pair := page.generatedPairs[row]
+	value := page.columns[column].value(pair)
+	if !page.columns[column].secret {
+		clipboard.WriteAll(value)
+		return
+	}
+	if err := page.clipboard.Copy(pair, value); err != nil {
+		page.err = err
+	}
+}
 
-	// prepare data
-	data := make([][]string, len(page.generatedAddresses))
-	for index := range page.generatedAddresses {
-		data[index] = []string{page.generatedAddresses[index], page.generatedPrivateKeys[index]}
+// generate generates as many key pairs as asked for on the selected network.
+func (page *AddressPage) generate() {
+	page.generatePairs(page.networkGroup.Value)
+}
+
+// export exports the generated key pairs, if there are any.
+func (page *AddressPage) export() {
+	if len(page.generatedPairs) == 0 || page.exportRequested || page.isExportingData {
+		return
+	}
+	page.exportRequested = true
+	page.exportModal.Show(i18n.T("Exporting data..."))
+}
+
+// HandleEvent generates on Ctrl+G and exports on Ctrl+E.
+func (page *AddressPage) HandleEvent(e key.Event) bool {
+	if page.exportModal.HandleEvent(e) {
+		return true
+	}
+	switch {
+	case isShortcut(e, "G"):
+		page.generate()
+	case isShortcut(e, "E"):
+		page.export()
+	default:
+		return false
 	}
+	return true
+}
 
-	// show exporting message
-	exportPath, err := helper.CreateCSV(data)
+// FocusedValue returns the text of the focused editor, or else the address
+// of the selected row.
+func (page *AddressPage) FocusedValue() (string, bool) {
+	if editor := focusedEditor(page.numOfItemsEditorWidget, page.recipientKeyEditorWidget); editor != nil {
+		return editor.Text(), false
+	}
+	if pair := page.selectedPair(); pair != nil {
+		return pair.Address, false
+	}
+	return "", false
+}
+
+// selectedPair returns the pair in the row selected in the table, if there
+// is one.
+func (page *AddressPage) selectedPair() *seedgen.KeyPair {
+	row, ok := page.table.Selected()
+	if !ok || row >= len(page.generatedPairs) {
+		return nil
+	}
+	return page.generatedPairs[row]
+}
+
+func (page *AddressPage) exportCSV() {
+	recipientKey := page.recipientKeyEditorWidget.Text()
+	if recipientKey == "" && page.config.ExportFormat == helper.ExportFormatEncryptedCSV {
+		page.notifier.Error(i18n.T("Exports are set to be encrypted, type in the recipient public key"))
+		return
+	}
+
+	var exporter seedgen.Exporter = seedgen.CSVExporter{}
+	if recipientKey != "" {
+		exporter = seedgen.EncryptedCSVExporter{RecipientKey: recipientKey}
+	}
+	exportPath, err := helper.CreateExport(exporter, page.generatedPairs)
 	if err != nil {
-		page.message.Message = "error exporting data: " + err.Error()
-		page.message.Variant = "error"
+		page.notifier.Error(i18n.T("error exporting data: %s", err))
 	} else {
-		page.message.Message = "Exported data to " + exportPath
-		page.message.Variant = "success"
+		page.notifier.Success(i18n.T("Exported data to %s", exportPath))
 	}
-	page.isExportingData = false
 }
 
 func (page *AddressPage) generatePairs(network string) {
 	numberOfItemsToGenerateStr := page.numOfItemsEditorWidget.Text()
 	if numberOfItemsToGenerateStr == "" {
-		page.err = errors.New("Please type in the required number of pairs")
+		page.err = errors.New(i18n.T("Please type in the required number of pairs"))
 		return
 	}
 
 	numberOfItemsToGenerate, err := strconv.Atoi(numberOfItemsToGenerateStr)
 	if err != nil {
-		page.err = errors.New("Please specify a valid number to generate")
+		page.err = errors.New(i18n.T("Please specify a valid number to generate"))
 		return
 	}
 
 	page.err = nil
 
-	page.generatedAddresses = make([]string, numberOfItemsToGenerate)
-	page.generatedPrivateKeys = make([]string, numberOfItemsToGenerate)
+	page.wipePairs()
+	page.generatedPairs = make([]*seedgen.KeyPair, numberOfItemsToGenerate)
+	page.keyReveals = make([]*theme.Reveal, numberOfItemsToGenerate)
 
 	for i := 0; i < numberOfItemsToGenerate; i++ {
-		privateKey, address, err := helper.GenerateAddressAndPrivateKey(network)
+		pair, err := seedgen.GenerateKeyPair(seedgen.Network(network), helper.GenerateOptions())
 		if err != nil {
 			page.err = err
+			page.wipePairs()
 			return
 		}
 
-		page.generatedAddresses[i] = address
-		page.generatedPrivateKeys[i] = privateKey
+		page.generatedPairs[i] = pair
+		page.keyReveals[i] = theme.NewReveal(page.reveal)
 	}
+	page.table.SetRowCount(len(page.generatedPairs))
+}
+
+// wipePairs wipes the private keys of the generated pairs and drops them.
+func (page *AddressPage) wipePairs() {
+	for _, pair := range page.generatedPairs {
+		if pair != nil {
+			pair.Wipe()
+		}
+	}
+	page.generatedPairs = nil
+	page.keyReveals = nil
+	page.table.SetRowCount(0)
 }
 
 func (page *AddressPage) Render(gtx layout.Context) layout.Dimensions {
@@ -200,109 +378,105 @@ func (page *AddressPage) Render(gtx layout.Context) layout.Dimensions {
 
 			return layout.Dimensions{}
 		},
-		func(gtx layout.Context) layout.Dimensions {
-			if page.message.Message != "" {
-				if page.message.Variant == "error" {
-					return page.theme.ErrorAlert(gtx, page.message.Message)
-				}
-				return page.theme.SuccessAlert(gtx, page.message.Message)
-			}
-			return layout.Dimensions{}
-		},
 		func(gtx layout.Context) layout.Dimensions {
 
-			if len(page.generatedAddresses) > 0 {
+			if len(page.generatedPairs) > 0 {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 						return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-							return page.renderHeader(gtx)
+							return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+									return page.renderColumnToggles(gtx)
+								}),
+								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+									return page.revealControls.Layout(gtx)
+								}),
+							)
 						})
 					}),
 					layout.Flexed(0.8, func(gtx layout.Context) layout.Dimensions {
 						gtx.Constraints.Max.Y = int(float32(0.67) * float32(maxHeight))
-						return page.addressList.Layout(gtx, len(page.generatedAddresses), func(gtx layout.Context, i int) layout.Dimensions {
-							return page.renderRow(gtx, i)
-						})
+						return page.table.Layout(gtx)
 					}),
 				)
 			}
 			return layout.Dimensions{}
 		},
 		func(gtx layout.Context) layout.Dimensions {
-			if len(page.generatedAddresses) > 0 {
-				return layout.Flex{Axis: layout.Vertical, Alignment: layout.Start}.Layout(gtx,
-					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						return page.exportIcon.Layout(gtx)
-					}),
-					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						return layout.Inset{Top: unit.Dp(3)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-							return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-								return page.theme.Caption("Export").Layout(gtx)
-							})
-						})
-					}),
-				)
+			if len(page.generatedPairs) > 0 {
+				return page.renderExportSection(gtx)
 			}
 			return layout.Dimensions{}
 		},
 	}
 
-	if page.isExportingData {
-		page.renderExportingModal(gtx)
+	dims := layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+			})
+		}),
+		layout.Expanded(page.exportModal.Layout),
+	)
+
+	if page.exportRequested {
+		page.exportRequested = false
+		page.isExportingData = true
+		op.InvalidateOp{}.Add(gtx.Ops)
 	}
 
-	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
-		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
-	})
+	return dims
 }
 
-func (page *AddressPage) renderHeader(gtx layout.Context) layout.Dimensions {
-	txt := page.theme.Label(unit.Dp(16), "#")
-	txt.Color = page.theme.Color.Hint
-
-	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-		layout.Flexed(numRowWidth, func(gtx layout.Context) layout.Dimensions {
-			return txt.Layout(gtx)
-		}),
-		layout.Flexed(addressRowWidth, func(gtx layout.Context) layout.Dimensions {
-			txt.Text = "Address"
-			return txt.Layout(gtx)
+func (page *AddressPage) renderExportSection(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Vertical, Alignment: layout.Start}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.exportIcon.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Top: unit.Dp(3)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							return page.theme.Caption(i18n.T("Export")).Layout(gtx)
+						})
+					})
+				}),
+			)
 		}),
-		layout.Flexed(privateKeyRowWidth, func(gtx layout.Context) layout.Dimensions {
-			txt.Text = "Private Key"
-			return txt.Layout(gtx)
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return page.recipientKeyEditorMaterial.Layout(gtx)
+			})
 		}),
 	)
 }
 
-func (page *AddressPage) renderRow(gtx layout.Context, index int) layout.Dimensions {
-	return layout.Inset{Bottom: unit.Dp(15)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-			layout.Flexed(numRowWidth, func(gtx layout.Context) layout.Dimensions {
-				return page.theme.Caption(strconv.Itoa(index + 1)).Layout(gtx)
-			}),
-			layout.Flexed(addressRowWidth, func(gtx layout.Context) layout.Dimensions {
-				return page.theme.Caption(page.generatedAddresses[index]).Layout(gtx)
-			}),
-			layout.Flexed(privateKeyRowWidth, func(gtx layout.Context) layout.Dimensions {
-				return page.theme.Caption(page.generatedPrivateKeys[index]).Layout(gtx)
-			}),
-		)
+func (page *AddressPage) renderColumnToggles(gtx layout.Context) layout.Dimensions {
+	list := layout.List{Axis: layout.Horizontal}
+	return list.Layout(gtx, len(page.columnCheckBoxMaterial), func(gtx layout.Context, index int) layout.Dimensions {
+		return layout.Inset{Right: unit.Dp(15)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return page.columnCheckBoxMaterial[index].Layout(gtx)
+		})
 	})
 }
 
-func (page *AddressPage) renderGeneratedPairs(gtx layout.Context) layout.Dimensions {
-	list := layout.List{Axis: layout.Vertical}
-	return list.Layout(gtx, len(page.generatedAddresses), func(gtx layout.Context, i int) layout.Dimensions {
-		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-				return page.theme.Body2(page.generatedAddresses[i]).Layout(gtx)
-			}),
-			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-				return page.theme.Body2(page.generatedPrivateKeys[i]).Layout(gtx)
-			}),
-		)
-	})
+func (page *AddressPage) renderSecretValue(gtx layout.Context, index int, value func() string) layout.Dimensions {
+	valueLabel := page.theme.MaskedLabel(page.theme.Caption(""), value, page.keyReveals[index])
+
+	// the value wraps in the space left by the countdown, long keys would
+	// otherwise run into the next column
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = 0
+			return valueLabel.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return copiedCountdown(page.theme, gtx, page.clipboard, page.generatedPairs[index])
+			})
+		}),
+	)
 }
 
 func (page *AddressPage) renderFormSection(gtx layout.Context) layout.Dimensions {
@@ -351,32 +525,3 @@ func (page *AddressPage) drawDivider(gtx layout.Context) layout.Dimensions {
 		}
 	})
 }
-
-func (page *AddressPage) renderExportingModal(gtx layout.Context) layout.Dimensions {
-	overlayColor := page.theme.Color.Black
-	overlayColor.A = 200
-
-	return layout.Stack{}.Layout(gtx,
-		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
-			return theme.FillMax(gtx, overlayColor)
-		}),
-		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-			hv := float32((gtx.Constraints.Max.Y / 2) - 30)
-
-			return layout.Inset{
-				Top:    unit.Dp(hv),
-				Bottom: unit.Dp(hv),
-				Left:   unit.Dp(80),
-				Right:  unit.Dp(80),
-			}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				theme.FillMax(gtx, page.theme.Color.Surface)
-
-				gtx.Constraints.Min = gtx.Constraints.Max
-
-				return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-					return page.exportingDataLabel.Layout(gtx)
-				})
-			})
-		}),
-	)
-}
@@ -0,0 +1,34 @@
+package pages
+
+import (
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+// copiedCountdown shows the "copied" label with the seconds left until the
+// secret copied from owner is taken off the clipboard, and redraws on every
+// tick until then.
+func copiedCountdown(th *theme.Theme, gtx layout.Context, secretClipboard *helper.SecretClipboard, owner interface{}) layout.Dimensions {
+	if !secretClipboard.Active() {
+		return layout.Dimensions{}
+	}
+
+	remaining := secretClipboard.Remaining(owner)
+	if remaining <= 0 {
+		return layout.Dimensions{}
+	}
+
+	seconds := (remaining + time.Second - 1) / time.Second
+	nextTick := remaining - (seconds-1)*time.Second
+	op.InvalidateOp{At: gtx.Now.Add(nextTick)}.Add(gtx.Ops)
+
+	label := th.Caption(i18n.N("copied, clears in %ds", "copied, clears in %ds", int(seconds), seconds))
+	label.Color = th.Color.Success
+	return label.Layout(gtx)
+}
@@ -0,0 +1,199 @@
+package pages
+
+import (
+	"errors"
+	"strconv"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const DecryptPageID = "DecryptPage"
+
+type DecryptPage struct {
+	pageHooks
+
+	theme *theme.Theme
+
+	headerLabel material.LabelStyle
+
+	fileEditorMaterial theme.Editor
+	fileEditorWidget   *widget.Editor
+
+	wifEditorMaterial theme.Editor
+	wifEditorWidget   *widget.Editor
+
+	decryptButtonMaterial theme.Button
+	decryptButtonWidget   *widget.Clickable
+
+	rows [][]string
+
+	list    *layout.List
+	rowList *layout.List
+	err     error
+}
+
+func NewDecryptPage(th *theme.Theme) *DecryptPage {
+	page := &DecryptPage{
+		theme: th,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.rowList = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Decrypt Export"))
+
+	page.fileEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.fileEditorMaterial = th.Editor(i18n.T("Path to encrypted export"), page.fileEditorWidget)
+
+	page.wifEditorWidget = &widget.Editor{
+		SingleLine: true,
+		Submit:     true,
+	}
+	page.wifEditorMaterial = th.Editor(i18n.T("Recipient private key (WIF)"), page.wifEditorWidget)
+
+	page.decryptButtonWidget = new(widget.Clickable)
+	page.decryptButtonMaterial = th.Button(i18n.T("Decrypt"), page.decryptButtonWidget)
+
+	return page
+}
+
+func (page *DecryptPage) OnEnter() {
+	page.clear()
+}
+
+// OnLeave clears the private key and the decrypted rows, which hold private
+// keys too, so that they don't stay in memory.
+func (page *DecryptPage) OnLeave() {
+	page.clear()
+}
+
+func (page *DecryptPage) clear() {
+	page.err = nil
+	page.rows = nil
+
+	page.wifEditorWidget.SetText("")
+}
+
+func (page *DecryptPage) handleEvents() {
+	for page.decryptButtonWidget.Clicked() {
+		page.decrypt()
+	}
+
+	if submitted(page.wifEditorWidget) {
+		page.decrypt()
+	}
+}
+
+// FocusedValue returns the text of the focused editor, the private key
+// editor holds a secret.
+func (page *DecryptPage) FocusedValue() (string, bool) {
+	editor := focusedEditor(page.fileEditorWidget, page.wifEditorWidget)
+	switch {
+	case editor == page.wifEditorWidget:
+		return editor.Text(), true
+	case editor != nil:
+		return editor.Text(), false
+	default:
+		return "", false
+	}
+}
+
+func (page *DecryptPage) decrypt() {
+	page.rows = nil
+
+	filename := page.fileEditorWidget.Text()
+	if filename == "" {
+		page.err = errors.New(i18n.T("Please type in the path to the encrypted export"))
+		return
+	}
+
+	wif := page.wifEditorWidget.Text()
+	if wif == "" {
+		page.err = errors.New(i18n.T("Please type in the private key of the recipient"))
+		return
+	}
+
+	rows, err := helper.ReadEncryptedCSV(filename, wif)
+	if err != nil {
+		page.err = errors.New(i18n.T("error decrypting export: %s", err))
+		return
+	}
+
+	page.err = nil
+	page.rows = rows
+}
+
+func (page *DecryptPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+	maxHeight := gtx.Constraints.Max.Y
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.fileEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.wifEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.decryptButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if len(page.rows) == 0 {
+				return layout.Dimensions{}
+			}
+
+			gtx.Constraints.Max.Y = int(float32(0.5) * float32(maxHeight))
+			return page.rowList.Layout(gtx, len(page.rows), func(gtx layout.Context, i int) layout.Dimensions {
+				return page.renderRow(gtx, i)
+			})
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *DecryptPage) renderRow(gtx layout.Context, index int) layout.Dimensions {
+	row := page.rows[index]
+	children := make([]layout.FlexChild, 0, len(row)+1)
+	children = append(children, layout.Flexed(numRowWidth, func(gtx layout.Context) layout.Dimensions {
+		return page.theme.Caption(strconv.Itoa(index + 1)).Layout(gtx)
+	}))
+
+	columnWidth := (1 - numRowWidth) / float32(len(row))
+	for i := range row {
+		value := row[i]
+		children = append(children, layout.Flexed(columnWidth, func(gtx layout.Context) layout.Dimensions {
+			return page.theme.Caption(value).Layout(gtx)
+		}))
+	}
+
+	return layout.Inset{Bottom: unit.Dp(15)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+	})
+}
@@ -0,0 +1,331 @@
+package pages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const (
+	ExportsPageID = "ExportsPage"
+
+	exportNameRowWidth    = 0.3
+	exportSizeRowWidth    = 0.1
+	exportTimeRowWidth    = 0.18
+	exportRowsRowWidth    = 0.07
+	exportNetworkRowWidth = 0.1
+	exportActionsRowWidth = 0.25
+)
+
+type (
+	exportRow struct {
+		file helper.ExportFile
+
+		previewButtonWidget *widget.Clickable
+		revealButtonWidget  *widget.Clickable
+		deleteButtonWidget  *widget.Clickable
+
+		isConfirmingDelete bool
+	}
+
+	ExportsPage struct {
+		pageHooks
+
+		theme *theme.Theme
+
+		headerLabel material.LabelStyle
+
+		refreshButtonMaterial theme.Button
+		refreshButtonWidget   *widget.Clickable
+
+		rows []*exportRow
+
+		previewFile *helper.ExportFile
+		previewRows [][]string
+
+		notifier *notify.Notifier
+
+		list        *layout.List
+		exportList  *layout.List
+		previewList *layout.List
+		err         error
+	}
+)
+
+func NewExportsPage(th *theme.Theme, notifier *notify.Notifier) *ExportsPage {
+	page := &ExportsPage{
+		theme:    th,
+		notifier: notifier,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.exportList = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.previewList = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Exports"))
+
+	page.refreshButtonWidget = new(widget.Clickable)
+	page.refreshButtonMaterial = th.Button(i18n.T("Refresh"), page.refreshButtonWidget)
+
+	return page
+}
+
+func (page *ExportsPage) OnEnter() {
+	page.closePreview()
+	page.refresh()
+}
+
+func (page *ExportsPage) closePreview() {
+	page.previewFile = nil
+	page.previewRows = nil
+}
+
+func (page *ExportsPage) refresh() {
+	files, err := helper.ListExports()
+	if err != nil {
+		page.err = err
+		return
+	}
+
+	page.err = nil
+	page.rows = make([]*exportRow, len(files))
+	for i := range files {
+		page.rows[i] = &exportRow{
+			file:                files[i],
+			previewButtonWidget: new(widget.Clickable),
+			revealButtonWidget:  new(widget.Clickable),
+			deleteButtonWidget:  new(widget.Clickable),
+		}
+	}
+}
+
+func (page *ExportsPage) handleEvents() {
+	for page.refreshButtonWidget.Clicked() {
+		page.refresh()
+	}
+
+	for _, row := range page.rows {
+		for row.previewButtonWidget.Clicked() {
+			page.preview(row)
+		}
+
+		for row.revealButtonWidget.Clicked() {
+			err := helper.RevealExport(row.file.Path)
+			if err != nil {
+				page.notifier.Error(i18n.T("error opening file manager: %s", err))
+			}
+		}
+
+		for row.deleteButtonWidget.Clicked() {
+			if !row.isConfirmingDelete {
+				row.isConfirmingDelete = true
+				continue
+			}
+			page.delete(row)
+		}
+	}
+}
+
+func (page *ExportsPage) preview(row *exportRow) {
+	rows, err := helper.ReadExport(row.file.Path)
+	if err != nil {
+		page.closePreview()
+		page.notifier.Error(i18n.T("error previewing export: %s", err))
+		return
+	}
+
+	page.previewFile = &row.file
+	page.previewRows = rows
+}
+
+func (page *ExportsPage) delete(row *exportRow) {
+	if page.previewFile != nil && page.previewFile.Path == row.file.Path {
+		page.closePreview()
+	}
+
+	err := helper.SecureDeleteExport(row.file.Path)
+	if err != nil {
+		page.notifier.Error(i18n.T("error deleting export: %s", err))
+	} else {
+		page.notifier.Success(i18n.T("Securely deleted %s", row.file.Name))
+	}
+	page.refresh()
+}
+
+func (page *ExportsPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+	maxHeight := gtx.Constraints.Max.Y
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.headerLabel.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.refreshButtonMaterial.Layout(gtx)
+				}),
+			)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.theme.Caption(helper.ExportDirectory()).Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if len(page.rows) == 0 {
+				return page.theme.Body1(i18n.T("No exports found")).Layout(gtx)
+			}
+
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return page.renderHeader(gtx)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					gtx.Constraints.Max.Y = int(float32(0.4) * float32(maxHeight))
+					return page.exportList.Layout(gtx, len(page.rows), func(gtx layout.Context, i int) layout.Dimensions {
+						return page.renderRow(gtx, page.rows[i])
+					})
+				}),
+			)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.previewFile == nil {
+				return layout.Dimensions{}
+			}
+			return page.renderPreview(gtx, maxHeight)
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *ExportsPage) renderHeader(gtx layout.Context) layout.Dimensions {
+	txt := page.theme.Label(unit.Dp(16), "File")
+	txt.Color = page.theme.Color.Hint
+
+	column := func(width float32, title string) layout.FlexChild {
+		return layout.Flexed(width, func(gtx layout.Context) layout.Dimensions {
+			txt.Text = title
+			return txt.Layout(gtx)
+		})
+	}
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		column(exportNameRowWidth, i18n.T("File")),
+		column(exportSizeRowWidth, i18n.T("Size")),
+		column(exportTimeRowWidth, i18n.T("Exported")),
+		column(exportRowsRowWidth, i18n.T("Rows")),
+		column(exportNetworkRowWidth, i18n.T("Network")),
+		column(exportActionsRowWidth, ""),
+	)
+}
+
+func (page *ExportsPage) renderRow(gtx layout.Context, row *exportRow) layout.Dimensions {
+	rows, network := "-", "-"
+	if row.file.Rows >= 0 {
+		rows = strconv.Itoa(row.file.Rows)
+	}
+	if row.file.Network != "" {
+		network = row.file.Network
+	}
+
+	deleteLabel := i18n.T("Delete")
+	if row.isConfirmingDelete {
+		deleteLabel = i18n.T("Confirm")
+	}
+
+	column := func(width float32, txt string) layout.FlexChild {
+		return layout.Flexed(width, func(gtx layout.Context) layout.Dimensions {
+			return page.theme.Caption(txt).Layout(gtx)
+		})
+	}
+
+	return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			column(exportNameRowWidth, row.file.Name),
+			column(exportSizeRowWidth, formatFileSize(row.file.Size)),
+			column(exportTimeRowWidth, row.file.ModTime.Format("2006-01-02 15:04:05")),
+			column(exportRowsRowWidth, rows),
+			column(exportNetworkRowWidth, network),
+			layout.Flexed(exportActionsRowWidth, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if row.file.Encrypted {
+							return layout.Dimensions{}
+						}
+						btn := page.theme.Button(i18n.T("Preview"), row.previewButtonWidget)
+						return layout.Inset{Right: unit.Dp(5)}.Layout(gtx, btn.Layout)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						btn := page.theme.SecondaryButton(i18n.T("Reveal"), row.revealButtonWidget)
+						return layout.Inset{Right: unit.Dp(5)}.Layout(gtx, btn.Layout)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						btn := page.theme.DangerButton(deleteLabel, row.deleteButtonWidget)
+						return btn.Layout(gtx)
+					}),
+				)
+			}),
+		)
+	})
+}
+
+func (page *ExportsPage) renderPreview(gtx layout.Context, maxHeight int) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return page.theme.H6(page.previewFile.Name).Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Max.Y = int(float32(0.4) * float32(maxHeight))
+			return page.previewList.Layout(gtx, len(page.previewRows), func(gtx layout.Context, i int) layout.Dimensions {
+				return layout.Inset{Bottom: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return page.theme.Caption(strconv.Itoa(i+1) + ". " + strings.Join(page.previewRows[i], "  ")).Layout(gtx)
+				})
+			})
+		}),
+	)
+}
+
+func formatFileSize(size int64) string {
+	const kilobyte = 1024
+	if size < kilobyte {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(kilobyte), 0
+	for n := size / kilobyte; n >= kilobyte; n /= kilobyte {
+		div *= kilobyte
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,246 @@
+package pages
+
+import (
+	"errors"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const (
+	InspectorPageID = "InspectorPage"
+
+	inspectionKeyWidth   = 0.2
+	inspectionValueWidth = 0.8
+)
+
+type InspectorPage struct {
+	pageHooks
+
+	theme *theme.Theme
+
+	headerLabel material.LabelStyle
+
+	inputEditorMaterial theme.Editor
+	inputEditorWidget   *widget.Editor
+
+	matchEditorMaterial theme.Editor
+	matchEditorWidget   *widget.Editor
+
+	inspectButtonMaterial theme.Button
+	inspectButtonWidget   *widget.Clickable
+
+	inspection *seedgen.Inspection
+	notifier   *notify.Notifier
+
+	list *layout.List
+	err  error
+}
+
+func NewInspectorPage(th *theme.Theme, notifier *notify.Notifier) *InspectorPage {
+	page := &InspectorPage{
+		theme:    th,
+		notifier: notifier,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Inspect Key or Address"))
+
+	page.inputEditorWidget = &widget.Editor{
+		SingleLine: true,
+		Submit:     true,
+	}
+	page.inputEditorMaterial = th.Editor(i18n.T("Private key (WIF) or address"), page.inputEditorWidget)
+
+	page.matchEditorWidget = &widget.Editor{
+		SingleLine: true,
+		Submit:     true,
+	}
+	page.matchEditorMaterial = th.Editor(i18n.T("Address expected for the private key (optional)"), page.matchEditorWidget)
+
+	page.inspectButtonWidget = new(widget.Clickable)
+	page.inspectButtonMaterial = th.Button(i18n.T("Inspect"), page.inspectButtonWidget)
+
+	return page
+}
+
+func (page *InspectorPage) OnEnter() {
+	page.clear()
+}
+
+// OnLeave clears the input, which may be a private key, and what was found
+// out about it so that they don't stay in memory.
+func (page *InspectorPage) OnLeave() {
+	page.clear()
+}
+
+func (page *InspectorPage) clear() {
+	page.reset()
+
+	page.inputEditorWidget.SetText("")
+	page.matchEditorWidget.SetText("")
+}
+
+func (page *InspectorPage) reset() {
+	page.err = nil
+	page.inspection = nil
+}
+
+func (page *InspectorPage) handleEvents() {
+	for page.inspectButtonWidget.Clicked() {
+		page.inspect()
+	}
+
+	if submitted(page.inputEditorWidget, page.matchEditorWidget) {
+		page.inspect()
+	}
+}
+
+// FocusedValue returns the text of the focused editor. The input may be a
+// private key, so it is treated as a secret.
+func (page *InspectorPage) FocusedValue() (string, bool) {
+	editor := focusedEditor(page.inputEditorWidget, page.matchEditorWidget)
+	switch {
+	case editor == page.inputEditorWidget:
+		return editor.Text(), true
+	case editor != nil:
+		return editor.Text(), false
+	default:
+		return "", false
+	}
+}
+
+func (page *InspectorPage) inspect() {
+	page.reset()
+
+	input := page.inputEditorWidget.Text()
+	if input == "" {
+		page.err = errors.New(i18n.T("Please type in a private key or an address to inspect"))
+		return
+	}
+
+	inspection, err := seedgen.Inspect(input)
+	if err != nil {
+		page.err = err
+		return
+	}
+	page.inspection = inspection
+
+	expectedAddress := page.matchEditorWidget.Text()
+	if expectedAddress == "" || inspection.Kind != seedgen.KindWIF {
+		return
+	}
+
+	if expectedAddress == inspection.Address {
+		page.notifier.Success(i18n.T("The private key matches the address"))
+	} else {
+		page.notifier.Error(i18n.T("The private key does not match the address"))
+	}
+}
+
+func (page *InspectorPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.inputEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.matchEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.inspectButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.inspection == nil {
+				return layout.Dimensions{}
+			}
+			return page.renderInspection(gtx)
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+// inspectionName translates the names seedgen gives kinds, address types and
+// signature types. Names of signature algorithms are the same in every
+// language and are shown as they are.
+func inspectionName(name string) string {
+	switch name {
+	case seedgen.KindWIF:
+		return i18n.T("Private key (WIF)")
+	case seedgen.KindAddress:
+		return i18n.T("Address")
+	case seedgen.AddressTypeP2PKH:
+		return i18n.T("Pay-to-pubkey-hash")
+	case seedgen.AddressTypeP2SH:
+		return i18n.T("Pay-to-script-hash")
+	case seedgen.AddressTypeP2PK:
+		return i18n.T("Pay-to-pubkey")
+	case seedgen.SignatureTypeUnknown:
+		return i18n.T("unknown")
+	default:
+		return name
+	}
+}
+
+func (page *InspectorPage) renderInspection(gtx layout.Context) layout.Dimensions {
+	addressTitle := i18n.T("Address")
+	if page.inspection.Kind == seedgen.KindWIF {
+		addressTitle = i18n.T("Derived address")
+	}
+
+	fields := [][2]string{
+		{i18n.T("Type"), inspectionName(page.inspection.Kind)},
+		{i18n.T("Network"), string(page.inspection.Network)},
+		{i18n.T("Address type"), inspectionName(page.inspection.AddressType)},
+		{i18n.T("Signature type"), inspectionName(page.inspection.SignatureType)},
+		{i18n.T("Public key"), page.inspection.PublicKey},
+		{i18n.T("Hash160"), page.inspection.Hash160},
+		{addressTitle, page.inspection.Address},
+	}
+
+	list := layout.List{Axis: layout.Vertical}
+	return list.Layout(gtx, len(fields), func(gtx layout.Context, i int) layout.Dimensions {
+		value := fields[i][1]
+		if value == "" {
+			value = "-"
+		}
+
+		title := page.theme.Body2(fields[i][0])
+		title.Color = page.theme.Color.Hint
+
+		return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Flexed(inspectionKeyWidth, func(gtx layout.Context) layout.Dimensions {
+					return title.Layout(gtx)
+				}),
+				layout.Flexed(inspectionValueWidth, func(gtx layout.Context) layout.Dimensions {
+					return page.theme.Body2(value).Layout(gtx)
+				}),
+			)
+		})
+	})
+}
@@ -0,0 +1,68 @@
+package pages
+
+import (
+	"gioui.org/io/key"
+	"gioui.org/widget"
+)
+
+// submitted drains the events of editors and reports whether Enter was
+// pressed in any of them. Only editors with Submit set send these.
+func submitted(editors ...*widget.Editor) bool {
+	submit := false
+	for _, editor := range editors {
+		for _, e := range editor.Events() {
+			if _, ok := e.(widget.SubmitEvent); ok {
+				submit = true
+			}
+		}
+	}
+	return submit
+}
+
+// focusedEditor returns whichever of editors has the keyboard focus, or nil.
+func focusedEditor(editors ...*widget.Editor) *widget.Editor {
+	for _, editor := range editors {
+		if editor.Focused() {
+			return editor
+		}
+	}
+	return nil
+}
+
+// moveFocus focuses the editor after the focused one, or the one before it
+// when going backwards, wrapping around at either end. The first (or last)
+// editor is focused if none of them has the focus yet.
+func moveFocus(editors []*widget.Editor, backwards bool) {
+	if len(editors) == 0 {
+		return
+	}
+
+	next := 0
+	if backwards {
+		next = len(editors) - 1
+	}
+	for i, editor := range editors {
+		if !editor.Focused() {
+			continue
+		}
+		if backwards {
+			next = (i - 1 + len(editors)) % len(editors)
+		} else {
+			next = (i + 1) % len(editors)
+		}
+		break
+	}
+	editors[next].Focus()
+}
+
+// isShortcut reports whether e is the key called name pressed along with the
+// shortcut modifier, Ctrl or Cmd on macOS.
+func isShortcut(e key.Event, name string) bool {
+	return e.Name == name && e.Modifiers.Contain(key.ModShortcut)
+}
+
+// isTab reports whether e is Tab, and whether Shift was held to go
+// backwards.
+func isTab(e key.Event) (tab, backwards bool) {
+	return e.Name == key.NameTab, e.Modifiers.Contain(key.ModShift)
+}
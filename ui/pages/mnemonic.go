@@ -0,0 +1,362 @@
+package pages
+
+import (
+	"strconv"
+	"strings"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const (
+	MnemonicPageID = "MnemonicPage"
+
+	defaultMnemonicWordCount = 24
+
+	// longestMnemonicWord is one of the longest words of the BIP39
+	// English word list.
+	longestMnemonicWord = "mushroom"
+)
+
+// MnemonicPage generates BIP39 mnemonics for hardware wallets along with the
+// keys of their first Decred account. It is kept apart from the seed page so
+// that the two formats can't be mistaken for one another.
+type MnemonicPage struct {
+	pageHooks
+
+	theme  *theme.Theme
+	config *helper.Config
+
+	mnemonic    *seedgen.Mnemonic
+	account     *seedgen.Account
+	wordReveals []*theme.Reveal
+	keyReveal   *theme.Reveal
+	err         error
+
+	reveal         *theme.Reveal
+	revealControls theme.RevealControls
+
+	headerLabel        material.LabelStyle
+	wordsHeaderLabel   material.LabelStyle
+	accountHeaderLabel material.LabelStyle
+
+	wordCountGroup         *widget.Enum
+	wordCountRadioMaterial []theme.RadioButton
+	networkGroup           *widget.Enum
+	networkRadioMaterial   []theme.RadioButton
+
+	passphraseEditorMaterial theme.Editor
+	passphraseEditorWidget   *widget.Editor
+
+	generateButtonMaterial theme.Button
+	generateButtonWidget   *widget.Clickable
+	deriveButtonMaterial   theme.Button
+	deriveButtonWidget     *widget.Clickable
+
+	regenerateModal *theme.Modal
+
+	list *layout.List
+}
+
+// NewMnemonicPage returns the BIP39 mnemonic page, keys are derived for the
+// default network set in cfg.
+func NewMnemonicPage(th *theme.Theme, cfg *helper.Config) *MnemonicPage {
+	page := &MnemonicPage{
+		theme:  th,
+		config: cfg,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.reveal = theme.NewReveal(nil)
+	page.revealControls = th.RevealControls(page.reveal)
+
+	page.headerLabel = th.H5(i18n.T("BIP39 Mnemonic (hardware wallets)"))
+	page.wordsHeaderLabel = th.H6(i18n.T("Mnemonic Words"))
+	page.accountHeaderLabel = th.H6(i18n.T("Account Keys"))
+
+	page.wordCountGroup = new(widget.Enum)
+	page.wordCountGroup.Value = strconv.Itoa(defaultMnemonicWordCount)
+	page.wordCountRadioMaterial = make([]theme.RadioButton, len(seedgen.MnemonicWordCounts))
+	for i, count := range seedgen.MnemonicWordCounts {
+		page.wordCountRadioMaterial[i] = th.RadioButton(strconv.Itoa(count), i18n.T("%d words", count), page.wordCountGroup)
+		page.wordCountRadioMaterial[i].Size = unit.Dp(20)
+	}
+
+	page.networkGroup = new(widget.Enum)
+	page.networkRadioMaterial = make([]theme.RadioButton, len(seedgen.Networks))
+	for i, network := range seedgen.Networks {
+		page.networkRadioMaterial[i] = th.RadioButton(string(network), string(network), page.networkGroup)
+		page.networkRadioMaterial[i].Size = unit.Dp(20)
+	}
+
+	page.passphraseEditorWidget = &widget.Editor{
+		SingleLine: true,
+		Submit:     true,
+	}
+	page.passphraseEditorMaterial = th.Editor(i18n.T("Passphrase (optional)"), page.passphraseEditorWidget)
+
+	page.generateButtonWidget = new(widget.Clickable)
+	page.generateButtonMaterial = th.Button(i18n.T("Regenerate"), page.generateButtonWidget)
+
+	page.deriveButtonWidget = new(widget.Clickable)
+	page.deriveButtonMaterial = th.SecondaryButton(i18n.T("Derive Keys"), page.deriveButtonWidget)
+
+	page.regenerateModal = th.Modal(i18n.T("Regenerate mnemonic?"), i18n.T("Regenerate"), i18n.T("Cancel"))
+
+	return page
+}
+
+func (page *MnemonicPage) OnEnter() {
+	page.networkGroup.Value = page.config.DefaultNetwork
+	page.passphraseEditorWidget.SetText("")
+	page.reveal.Hide()
+	page.generate()
+}
+
+// OnLeave wipes the mnemonic and keys and clears the passphrase, a new
+// mnemonic is generated when the page is opened again.
+func (page *MnemonicPage) OnLeave() {
+	page.regenerateModal.Hide()
+	page.wipe()
+	page.passphraseEditorWidget.SetText("")
+	page.reveal.Hide()
+}
+
+// regenerate asks before discarding the mnemonic being shown, a new one is
+// generated once that is confirmed.
+func (page *MnemonicPage) regenerate() {
+	if page.mnemonic == nil {
+		page.generate()
+		return
+	}
+	page.regenerateModal.Show(i18n.T("Regenerating discards this mnemonic, it can't be shown again. Make sure it was written down or isn't needed."))
+}
+
+// wipe wipes the mnemonic and keys being shown.
+func (page *MnemonicPage) wipe() {
+	if page.account != nil {
+		page.account.Wipe()
+		page.account = nil
+	}
+	if page.mnemonic != nil {
+		page.mnemonic.Wipe()
+		page.mnemonic = nil
+	}
+}
+
+func (page *MnemonicPage) generate() {
+	page.wipe()
+
+	wordCount, _ := strconv.Atoi(page.wordCountGroup.Value)
+	mnemonic, err := seedgen.GenerateMnemonic(wordCount, helper.GenerateOptions())
+	if err != nil {
+		page.err = err
+		return
+	}
+	page.err = nil
+	page.mnemonic = mnemonic
+
+	page.wordReveals = make([]*theme.Reveal, wordCount)
+	for index := range page.wordReveals {
+		page.wordReveals[index] = theme.NewReveal(page.reveal)
+	}
+	page.keyReveal = theme.NewReveal(page.reveal)
+
+	page.derive()
+}
+
+// derive derives the account keys of the mnemonic with the passphrase typed
+// in, for the network picked.
+func (page *MnemonicPage) derive() {
+	if page.mnemonic == nil {
+		return
+	}
+	if page.account != nil {
+		page.account.Wipe()
+		page.account = nil
+	}
+
+	account, err := page.mnemonic.Account(seedgen.Network(page.networkGroup.Value), 0, page.passphraseEditorWidget.Text())
+	if err != nil {
+		page.err = err
+		return
+	}
+	page.err = nil
+	page.account = account
+}
+
+func (page *MnemonicPage) handleEvents() {
+	for page.generateButtonWidget.Clicked() {
+		page.regenerate()
+	}
+
+	if page.regenerateModal.Confirmed() {
+		page.generate()
+	}
+	if page.regenerateModal.Dismissed() {
+		// keep the word count of the mnemonic that was kept
+		page.wordCountGroup.Value = strconv.Itoa(len(page.wordReveals))
+	}
+
+	for page.deriveButtonWidget.Clicked() {
+		page.derive()
+	}
+
+	// the radio buttons update their values while being laid out, so a
+	// different pick shows up on the next frame. Another word count needs
+	// a new mnemonic, which is confirmed first too.
+	if page.mnemonic != nil && page.wordCountGroup.Value != strconv.Itoa(len(page.wordReveals)) && !page.regenerateModal.Visible() {
+		page.regenerate()
+	}
+	if page.account != nil && page.networkGroup.Value != string(page.account.Network) {
+		page.derive()
+	}
+
+	if submitted(page.passphraseEditorWidget) {
+		page.derive()
+	}
+}
+
+// HandleEvent regenerates the mnemonic on Ctrl+G.
+func (page *MnemonicPage) HandleEvent(e key.Event) bool {
+	if page.regenerateModal.HandleEvent(e) {
+		return true
+	}
+	if !isShortcut(e, "G") {
+		return false
+	}
+	page.regenerate()
+	return true
+}
+
+// FocusedValue returns the passphrase while it is being typed in, or the
+// mnemonic words.
+func (page *MnemonicPage) FocusedValue() (string, bool) {
+	if page.passphraseEditorWidget.Focused() {
+		return page.passphraseEditorWidget.Text(), true
+	}
+	if page.mnemonic == nil {
+		return "", false
+	}
+	return strings.Join(page.mnemonic.Words(), " "), true
+}
+
+func (page *MnemonicPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(page.renderMnemonicPage),
+		layout.Expanded(page.regenerateModal.Layout),
+	)
+}
+
+func (page *MnemonicPage) renderMnemonicPage(gtx layout.Context) layout.Dimensions {
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(page.headerLabel.Layout),
+				layout.Rigid(page.revealControls.Layout),
+			)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return page.theme.WarningAlert(gtx, i18n.T("This is not a dcrwallet seed. BIP39 mnemonics are for hardware wallets, dcrwallet and Decrediton can't restore them. Use the Generate Seed tab for dcrwallet."))
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return radioRow(gtx, page.wordCountRadioMaterial)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return radioRow(gtx, page.networkRadioMaterial)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(page.passphraseEditorMaterial.Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					hint := page.theme.Caption(i18n.T("The passphrase is part of the wallet, the same words with another passphrase restore a different wallet."))
+					hint.Color = page.theme.Color.Hint
+					return layout.Inset{Top: unit.Dp(5)}.Layout(gtx, hint.Layout)
+				}),
+			)
+		},
+	}
+
+	if page.err != nil {
+		w = append(w, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return page.theme.ErrorAlert(gtx, page.err.Error())
+		})
+	}
+
+	if page.mnemonic != nil {
+		w = append(w,
+			page.wordsHeaderLabel.Layout,
+			func(gtx layout.Context) layout.Dimensions {
+				word := func(index int) string {
+					return page.mnemonic.Word(index)
+				}
+				return wordColumns(page.theme, gtx, word, page.wordReveals, longestMnemonicWord)
+			},
+		)
+	}
+
+	if page.account != nil {
+		w = append(w, page.accountHeaderLabel.Layout, page.renderAccount)
+	}
+
+	w = append(w, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+			layout.Rigid(page.generateButtonMaterial.Layout),
+			layout.Rigid(page.deriveButtonMaterial.Layout),
+		)
+	})
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *MnemonicPage) renderAccount(gtx layout.Context) layout.Dimensions {
+	field := func(title string, value layout.Widget) layout.FlexChild {
+		titleLabel := page.theme.Body2(title)
+		titleLabel.Color = page.theme.Color.Hint
+
+		return layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Flexed(inspectionKeyWidth, titleLabel.Layout),
+					layout.Flexed(inspectionValueWidth, value),
+				)
+			})
+		})
+	}
+	text := func(value string) layout.Widget {
+		return page.theme.Body2(value).Layout
+	}
+
+	privateKey := page.theme.MaskedLabel(page.theme.Body2(""), page.account.ExtendedPrivateKey, page.keyReveal)
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		field(i18n.T("Derivation path"), text(page.account.Path)),
+		field(i18n.T("Extended public key"), text(page.account.ExtendedPublicKey)),
+		field(i18n.T("Extended private key"), privateKey.Layout),
+		field(i18n.T("First address"), text(page.account.FirstAddress)),
+	)
+}
+
+// radioRow lays out radio buttons next to each other.
+func radioRow(gtx layout.Context, radios []theme.RadioButton) layout.Dimensions {
+	list := layout.List{Axis: layout.Horizontal}
+	return list.Layout(gtx, len(radios), func(gtx layout.Context, index int) layout.Dimensions {
+		return layout.Inset{Right: unit.Dp(10)}.Layout(gtx, radios[index].Layout)
+	})
+}
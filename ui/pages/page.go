@@ -0,0 +1,25 @@
+package pages
+
+import (
+	"gioui.org/io/key"
+)
+
+// Navigator opens and closes pages, it is implemented by the window's
+// router.
+type Navigator interface {
+	// Push opens the page called id on top of the current one.
+	Push(id string)
+	// Back closes the current page, returning false if it is the only one
+	// open.
+	Back() bool
+}
+
+// pageHooks gives pages lifecycle and event hooks that do nothing, pages
+// embed it and override the ones they need.
+type pageHooks struct{}
+
+func (pageHooks) OnEnter() {}
+
+func (pageHooks) OnLeave() {}
+
+func (pageHooks) HandleEvent(key.Event) bool { return false }
@@ -1,98 +1,110 @@
 package pages
 
 import (
+	"crypto/rand"
+	"math/big"
+	"sort"
 	"strconv"
-	"strings"
-	"time"
 
+	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/unit"
 	"gioui.org/widget"
 	"gioui.org/widget/material"
 
-	"github.com/atotto/clipboard"
 	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
 	"github.com/raedahgroup/dcrseedgen/ui/theme"
 	"golang.org/x/exp/shiny/materialdesign/icons"
 )
 
 type (
-	column struct {
-		words   []string
-		editors []*widget.Editor
+	// verifyWord is a seed word that has to be typed in to verify the seed.
+	verifyWord struct {
+		index  int
+		editor *widget.Editor
 	}
 
+	// seed keeps the generated seed as a secret, its words and hex are
+	// only worked out when drawing or verifying them.
 	seed struct {
-		seedStr string
-		columns []column
+		secret    *seedgen.Seed
+		reveals   []*theme.Reveal
+		verify    []verifyWord
+		hexReveal *theme.Reveal
 	}
 
 	SeedPage struct {
-		theme       *theme.Theme
-		currentPage string
-		seed        *seed
-		err         error
+		pageHooks
 
-		hasCopiedHexSeed bool
+		theme     *theme.Theme
+		clipboard *helper.SecretClipboard
+		config    *helper.Config
+		seed      *seed
+		err       error
 
-		list                        *layout.List
-		seedWordsHeaderLabel        material.LabelStyle
-		seedHexHeaderLabel          material.LabelStyle
-		seedVerificationHeaderLabel material.LabelStyle
-		copiedLabel                 material.LabelStyle
+		reveal         *theme.Reveal
+		revealControls theme.RevealControls
 
-		isShowingVerificationPage bool
+		navigator Navigator
+
+		list                 *layout.List
+		seedWordsHeaderLabel material.LabelStyle
+		seedHexHeaderLabel   material.LabelStyle
 
 		verifyButtonMaterial theme.Button
 		verifyButtonWidget   *widget.Clickable
 
-		backVerificationButtonMaterial theme.Button
-		backVerificationButtonWidget   *widget.Clickable
-
-		doVerifyButtonMaterial theme.Button
-		doVerifyButtonWidget   *widget.Clickable
-
 		generateButtonMaterial theme.Button
 		generateButtonWidget   *widget.Clickable
 
 		copyIconMaterial theme.IconButton
 		copyIconWidget   *widget.Clickable
 
-		verifyMessage helper.Message
+		regenerateModal *theme.Modal
 	}
 )
 
 const (
 	SeedPageID = "SeedPage"
 
-	seedSize        = 32 // affects number of words // noOfWords = (seedSize+1)
-	numberOfColumns = 5
-	numberOfRows    = 7
+	// longestSeedWord is one of the longest words of the PGP word list
+	// seeds are encoded with, the word grid leaves room for it in every
+	// column.
+	longestSeedWord = "paperweight"
+
+	// verifyWordCount is how many words are asked for when only some of
+	// them are verified.
+	verifyWordCount = 6
 )
 
-func NewSeedPage(th *theme.Theme) *SeedPage {
+// NewSeedPage returns the seed generation page, seeds are generated with the
+// size and verified in the mode set in cfg. The verification is opened with
+// navigator.
+func NewSeedPage(th *theme.Theme, secretClipboard *helper.SecretClipboard, cfg *helper.Config, navigator Navigator) *SeedPage {
 	page := &SeedPage{
-		theme:         th,
-		verifyMessage: helper.Message{},
+		theme:     th,
+		clipboard: secretClipboard,
+		config:    cfg,
+		navigator: navigator,
 	}
 
 	page.list = &layout.List{
 		Axis: layout.Vertical,
 	}
 
-	page.hasCopiedHexSeed = false
-	page.isShowingVerificationPage = false
+	page.reveal = theme.NewReveal(nil)
+	page.revealControls = th.RevealControls(page.reveal)
 
-	page.seedWordsHeaderLabel = th.H5("Seed Words")
-	page.seedHexHeaderLabel = th.H5("Seed Hex")
-	page.copiedLabel = th.Caption("copied")
-	page.seedVerificationHeaderLabel = th.H5("Verify Seed Words")
+	page.seedWordsHeaderLabel = th.H5(i18n.T("Seed Words"))
+	page.seedHexHeaderLabel = th.H5(i18n.T("Seed Hex"))
 
 	page.verifyButtonWidget = new(widget.Clickable)
-	page.verifyButtonMaterial = th.Button("Verify", page.verifyButtonWidget)
+	page.verifyButtonMaterial = th.Button(i18n.T("Verify"), page.verifyButtonWidget)
 
 	page.generateButtonWidget = new(widget.Clickable)
-	page.generateButtonMaterial = th.Button("Regenerate", page.generateButtonWidget)
+	page.generateButtonMaterial = th.Button(i18n.T("Regenerate"), page.generateButtonWidget)
 
 	page.copyIconWidget = new(widget.Clickable)
 	page.copyIconMaterial = th.IconButton(theme.MustIcon(theme.NewIcon(icons.ContentContentCopy)), page.copyIconWidget)
@@ -101,90 +113,193 @@ func NewSeedPage(th *theme.Theme) *SeedPage {
 	page.copyIconMaterial.Size = unit.Dp(25)
 	page.copyIconMaterial.Padding = unit.Dp(5)
 
-	page.doVerifyButtonWidget = new(widget.Clickable)
-	page.doVerifyButtonMaterial = th.Button("Verify", page.doVerifyButtonWidget)
-
-	page.backVerificationButtonWidget = new(widget.Clickable)
-	page.backVerificationButtonMaterial = th.DangerButton("Back", page.backVerificationButtonWidget)
-
-	page.copiedLabel.Color = th.Color.Success
+	page.regenerateModal = th.Modal(i18n.T("Regenerate seed?"), i18n.T("Regenerate"), i18n.T("Cancel"))
 
 	return page
 }
 
+// discard wipes the seed being shown, if there is one.
+func (page *SeedPage) discard() {
+	if page.seed != nil {
+		page.seed.secret.Wipe()
+		page.seed = nil
+	}
+}
+
 func (page *SeedPage) generate() {
-	words, seedStr, err := helper.GenerateMnemonicSeed(seedSize)
+	// wipe the seed being replaced
+	page.discard()
+
+	secret, err := seedgen.GenerateSeed(uint(page.config.SeedSize), helper.GenerateOptions())
 	if err != nil {
 		page.err = err
 		return
 	}
+	page.err = nil
 
-	page.seed = &seed{
-		seedStr: seedStr,
-		columns: make([]column, numberOfColumns),
+	numberOfWords := page.config.SeedSize + 1
+	verifyIndexes, err := page.verifyIndexes(numberOfWords)
+	if err != nil {
+		secret.Wipe()
+		page.err = err
+		return
 	}
 
-	wordSlice := strings.Split(words, " ")
-	currentColumn := 0
+	page.seed = &seed{
+		secret:    secret,
+		reveals:   make([]*theme.Reveal, numberOfWords),
+		hexReveal: theme.NewReveal(page.reveal),
+	}
+	for index := range page.seed.reveals {
+		page.seed.reveals[index] = theme.NewReveal(page.reveal)
+	}
+	for _, index := range verifyIndexes {
+		page.seed.verify = append(page.seed.verify, verifyWord{
+			index: index,
+			editor: &widget.Editor{
+				SingleLine: true,
+				Submit:     true,
+			},
+		})
+	}
+}
 
-	for index, word := range wordSlice {
-		page.seed.columns[currentColumn].words = append(page.seed.columns[currentColumn].words, word)
-		editor := &widget.Editor{
-			SingleLine: true,
-			Submit:     true,
+// verifyIndexes returns the indexes of the words that have to be typed in,
+// every word or a few picked at random depending on the verification mode.
+func (page *SeedPage) verifyIndexes(numberOfWords int) ([]int, error) {
+	if page.config.VerificationMode != helper.VerifySomeWords || numberOfWords <= verifyWordCount {
+		indexes := make([]int, numberOfWords)
+		for i := range indexes {
+			indexes[i] = i
 		}
-		page.seed.columns[currentColumn].editors = append(page.seed.columns[currentColumn].editors, editor)
+		return indexes, nil
+	}
 
-		if index > 0 && (index+1)%numberOfRows == 0 {
-			currentColumn++
+	picked := make(map[int]bool, verifyWordCount)
+	indexes := make([]int, 0, verifyWordCount)
+	for len(indexes) < verifyWordCount {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(numberOfWords)))
+		if err != nil {
+			return nil, err
+		}
+		index := int(n.Int64())
+		if !picked[index] {
+			picked[index] = true
+			indexes = append(indexes, index)
 		}
 	}
+	sort.Ints(indexes)
+	return indexes, nil
 }
 
-func (page *SeedPage) BeforeRender() {
-	page.resetSeedGenerationPage()
-	page.resetVerificationPage()
+// editors returns the verification editors in word order.
+func (s *seed) editors() []*widget.Editor {
+	editors := make([]*widget.Editor, len(s.verify))
+	for i := range s.verify {
+		editors[i] = s.verify[i].editor
+	}
+	return editors
 }
 
-func (page *SeedPage) handleEvents(gtx layout.Context) {
-	if page.hasCopiedHexSeed {
-		time.AfterFunc(3*time.Second, func() {
-			page.hasCopiedHexSeed = false
-		})
+// OnEnter generates a new seed. The seed is kept while it is being verified,
+// the verification page is opened on top of this one and doesn't close it.
+func (page *SeedPage) OnEnter() {
+	page.err = nil
+	page.reveal.Hide()
+	page.generate()
+}
+
+// OnLeave closes the regenerate confirmation and wipes the seed, a new one
+// is generated when the page is opened again.
+func (page *SeedPage) OnLeave() {
+	page.regenerateModal.Hide()
+	page.discard()
+}
+
+// regenerate asks before discarding the seed being shown, a new seed is
+// generated once that is confirmed.
+func (page *SeedPage) regenerate() {
+	if page.seed == nil {
+		page.generate()
+		return
 	}
+	page.regenerateModal.Show(i18n.T("Regenerating discards this seed, it can't be shown again. Make sure it was written down or isn't needed."))
+}
 
+func (page *SeedPage) handleEvents(gtx layout.Context) {
 	for page.generateButtonWidget.Clicked() {
+		page.regenerate()
+	}
+
+	if page.regenerateModal.Confirmed() {
 		page.generate()
 	}
 
 	for page.copyIconWidget.Clicked() {
-		clipboard.WriteAll(page.seed.seedStr)
-		page.hasCopiedHexSeed = true
+		err := page.clipboard.Copy(page.seed.secret, page.seed.secret.Hex())
+		if err != nil {
+			page.err = err
+		}
 	}
 
 	for page.verifyButtonWidget.Clicked() {
-		page.isShowingVerificationPage = true
+		page.navigator.Push(SeedVerificationPageID)
 	}
 }
 
-func (page *SeedPage) Render(gtx layout.Context) layout.Dimensions {
-	page.handleEvents(gtx)
+// HandleEvent regenerates the seed on Ctrl+G.
+func (page *SeedPage) HandleEvent(e key.Event) bool {
+	if page.regenerateModal.HandleEvent(e) {
+		return true
+	}
+	if !isShortcut(e, "G") {
+		return false
+	}
+	page.regenerate()
+	return true
+}
 
-	if page.isShowingVerificationPage {
-		return page.renderSeedVerificationPage(gtx)
+// FocusedValue returns the seed hex.
+func (page *SeedPage) FocusedValue() (string, bool) {
+	if page.seed == nil {
+		return "", false
 	}
-	return page.renderSeedGenerationPage(gtx)
+	return page.seed.secret.Hex(), true
+}
+
+func (page *SeedPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents(gtx)
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(page.renderSeedGenerationPage),
+		layout.Expanded(page.regenerateModal.Layout),
+	)
 }
 
 func (page *SeedPage) renderSeedGenerationPage(gtx layout.Context) layout.Dimensions {
 	if page.err != nil {
-		gtx.Constraints.Min.X = gtx.Constraints.Max.X
-		return page.theme.ErrorAlert(gtx, page.err.Error())
+		// keep the regenerate button so that generating can be retried,
+		// e.g. once the machine is offline in strict air-gap mode
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(10)).Layout(gtx, page.generateButtonMaterial.Layout)
+			}),
+		)
 	}
 
 	w := []layout.Widget{
 		func(gtx layout.Context) layout.Dimensions {
-			return page.seedWordsHeaderLabel.Layout(gtx)
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.seedWordsHeaderLabel.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.revealControls.Layout(gtx)
+				}),
+			)
 		},
 		func(gtx layout.Context) layout.Dimensions {
 			return page.renderWordColumns(gtx)
@@ -195,7 +310,8 @@ func (page *SeedPage) renderSeedGenerationPage(gtx layout.Context) layout.Dimens
 		func(gtx layout.Context) layout.Dimensions {
 			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-					return page.theme.Body1(page.seed.seedStr).Layout(gtx)
+					hexLabel := page.theme.MaskedLabel(page.theme.Body1(""), page.seed.secret.Hex, page.seed.hexReveal)
+					return hexLabel.Layout(gtx)
 				}),
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
@@ -205,12 +321,9 @@ func (page *SeedPage) renderSeedGenerationPage(gtx layout.Context) layout.Dimens
 							})
 						}),
 						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-							if page.hasCopiedHexSeed {
-								return layout.Inset{Top: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-									return page.copiedLabel.Layout(gtx)
-								})
-							}
-							return layout.Dimensions{}
+							return layout.Inset{Top: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								return copiedCountdown(page.theme, gtx, page.clipboard, page.seed.secret)
+							})
 						}),
 					)
 				}),
@@ -218,7 +331,7 @@ func (page *SeedPage) renderSeedGenerationPage(gtx layout.Context) layout.Dimens
 		},
 		func(gtx layout.Context) layout.Dimensions {
 			insetTop := float32(35)
-			if page.hasCopiedHexSeed {
+			if page.clipboard.Remaining(page.seed.secret) > 0 {
 				insetTop = 20
 			}
 
@@ -241,148 +354,42 @@ func (page *SeedPage) renderSeedGenerationPage(gtx layout.Context) layout.Dimens
 }
 
 func (page *SeedPage) renderWordColumns(gtx layout.Context) layout.Dimensions {
-	colWidth := gtx.Constraints.Max.X / len(page.seed.columns)
-
-	currentItem := 1
-	columnList := layout.List{Axis: layout.Horizontal}
-	return columnList.Layout(gtx, len(page.seed.columns), func(gtx layout.Context, i int) layout.Dimensions {
-		wordList := layout.List{Axis: layout.Vertical}
-		return wordList.Layout(gtx, len(page.seed.columns[i].words), func(gtx layout.Context, j int) layout.Dimensions {
-			w := layout.Inset{
-				Bottom: unit.Dp(10),
-			}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				gtx.Constraints.Min.X = colWidth
-				return page.theme.Body1(strconv.Itoa(currentItem) + ". " + page.seed.columns[i].words[j]).Layout(gtx)
-			})
-
-			currentItem++
-			return w
-		})
-	})
-}
-
-func (page *SeedPage) renderSeedVerificationPage(gtx layout.Context) layout.Dimensions {
-	page.handleVerificationEvents()
-
-	w := []layout.Widget{
-		func(gtx layout.Context) layout.Dimensions {
-			return page.seedVerificationHeaderLabel.Layout(gtx)
-		},
-		func(gtx layout.Context) layout.Dimensions {
-			if page.verifyMessage.Message != "" {
-				if page.verifyMessage.Variant == "success" {
-					return page.theme.SuccessAlert(gtx, page.verifyMessage.Message)
-				} else {
-					return page.theme.ErrorAlert(gtx, page.verifyMessage.Message)
-				}
-			}
-
-			return layout.Dimensions{}
-		},
-		func(gtx layout.Context) layout.Dimensions {
-			return layout.Inset{Top: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				return page.renderInputColumns(gtx)
-			})
-		},
-		func(gtx layout.Context) layout.Dimensions {
-			return layout.Inset{Top: unit.Dp(30)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
-					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						return page.backVerificationButtonMaterial.Layout(gtx)
-					}),
-					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						return page.doVerifyButtonMaterial.Layout(gtx)
-					}),
-				)
-			})
-		},
+	word := func(index int) string {
+		return page.seed.secret.Word(index)
 	}
-
-	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
-		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
-	})
+	return wordColumns(page.theme, gtx, word, page.seed.reveals, longestSeedWord)
 }
 
-func (page *SeedPage) handleVerificationEvents() {
-	for page.backVerificationButtonWidget.Clicked() {
-		page.isShowingVerificationPage = false
-		page.resetVerificationPage()
-	}
+// wordGrid is the grid seed words are drawn and typed into, it has between
+// one and six columns depending on the width of the window.
+var wordGrid = theme.Grid{MinColumns: 1, MaxColumns: 6, Gap: unit.Dp(20)}
 
-	for page.doVerifyButtonWidget.Clicked() {
-		page.doVerification()
-	}
+// wordCellWidth returns how wide a numbered word of up to n words needs to
+// be, leaving extra pixels for what is drawn next to the word.
+func wordCellWidth(th *theme.Theme, gtx layout.Context, n int, longestWord string, extra unit.Value) int {
+	return th.TextWidth(gtx, th.TextSize, strconv.Itoa(n)+". "+longestWord) + gtx.Px(extra)
 }
 
-func (page *SeedPage) renderInputColumns(gtx layout.Context) layout.Dimensions {
-	currentItem := 1
-	maxWidth := gtx.Constraints.Max.X
+// wordColumns draws numbered words in as many columns as fit, each masked
+// until its reveal is shown. word is only called for revealed words.
+func wordColumns(th *theme.Theme, gtx layout.Context, word func(index int) string, reveals []*theme.Reveal, longestWord string) layout.Dimensions {
+	// the reveal toggle is drawn after the word
+	cellWidth := wordCellWidth(th, gtx, len(reveals), longestWord, unit.Dp(25))
+
+	return wordGrid.Layout(gtx, len(reveals), cellWidth, func(gtx layout.Context, index int) layout.Dimensions {
+		wordLabel := th.MaskedLabel(th.Body1(""), func() string { return word(index) }, reveals[index])
 
-	return (&layout.List{Axis: layout.Horizontal}).Layout(gtx, len(page.seed.columns), func(gtx layout.Context, i int) layout.Dimensions {
 		return layout.Inset{
-			Right: unit.Dp(30),
+			Bottom: unit.Dp(10),
 		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-			return (&layout.List{Axis: layout.Vertical}).Layout(gtx, len(page.seed.columns[i].editors), func(gtx layout.Context, j int) layout.Dimensions {
-				dims := layout.Inset{
-					Bottom: unit.Dp(10),
-				}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-					gtx.Constraints.Max.X = maxWidth / (numberOfColumns + 1)
-					gtx.Constraints.Min.X = gtx.Constraints.Max.X
-					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-							return page.theme.Body1(strconv.Itoa(currentItem)).Layout(gtx)
-						}),
-						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-							gtx.Constraints.Min.X = gtx.Constraints.Max.X
-							return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-								return page.theme.Editor("", page.seed.columns[i].editors[j]).Layout(gtx)
-							})
-						}),
-					)
-				})
-				currentItem++
-				return dims
-			})
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return th.Body1(strconv.Itoa(index+1) + ". ").Layout(gtx)
+				}),
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return wordLabel.Layout(gtx)
+				}),
+			)
 		})
 	})
 }
-
-func (page *SeedPage) doVerification() {
-	for range page.seed.columns {
-		for columnIndex := range page.seed.columns {
-			for itemIndex := range page.seed.columns[columnIndex].words {
-				if page.seed.columns[columnIndex].words[itemIndex] != page.seed.columns[columnIndex].editors[itemIndex].Text() {
-					page.verifyMessage.Message = "Invalid verification words. Please check the words and try again"
-					page.verifyMessage.Variant = "error"
-					return
-				}
-			}
-		}
-	}
-	page.verifyMessage.Message = "Verification successfull"
-	page.verifyMessage.Variant = "success"
-}
-
-func (page *SeedPage) resetVerificationPage() {
-	page.verifyMessage.Message = ""
-	page.verifyMessage.Variant = ""
-
-	for range page.seed.columns {
-		for columnIndex := range page.seed.columns {
-			for itemIndex := range page.seed.columns[columnIndex].words {
-				page.seed.columns[columnIndex].editors[itemIndex].SetText("")
-			}
-		}
-	}
-}
-
-func (page *SeedPage) resetSeedGenerationPage() {
-	page.err = nil
-
-	if page.isShowingVerificationPage {
-		return
-	}
-
-	page.seed = nil
-	page.generate()
-}
@@ -0,0 +1,189 @@
+package pages
+
+import (
+	"strconv"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const SeedVerificationPageID = "SeedVerificationPage"
+
+// SeedVerificationPage asks for the words of the seed being shown on the
+// seed page. It is opened on top of the seed page so that going back keeps
+// the seed.
+type SeedVerificationPage struct {
+	pageHooks
+
+	theme     *theme.Theme
+	notifier  *notify.Notifier
+	seedPage  *SeedPage
+	navigator Navigator
+
+	list        *layout.List
+	headerLabel material.LabelStyle
+
+	backButtonMaterial theme.Button
+	backButtonWidget   *widget.Clickable
+
+	doVerifyButtonMaterial theme.Button
+	doVerifyButtonWidget   *widget.Clickable
+}
+
+// NewSeedVerificationPage returns the page verifying the seed of seedPage,
+// its back button closes it with navigator.
+func NewSeedVerificationPage(th *theme.Theme, notifier *notify.Notifier, seedPage *SeedPage, navigator Navigator) *SeedVerificationPage {
+	page := &SeedVerificationPage{
+		theme:     th,
+		notifier:  notifier,
+		seedPage:  seedPage,
+		navigator: navigator,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Verify Seed Words"))
+
+	page.doVerifyButtonWidget = new(widget.Clickable)
+	page.doVerifyButtonMaterial = th.Button(i18n.T("Verify"), page.doVerifyButtonWidget)
+
+	page.backButtonWidget = new(widget.Clickable)
+	page.backButtonMaterial = th.DangerButton(i18n.T("Back"), page.backButtonWidget)
+
+	return page
+}
+
+func (page *SeedVerificationPage) OnEnter() {
+	page.reset()
+}
+
+// OnLeave clears the words typed in so that they don't stay in memory.
+func (page *SeedVerificationPage) OnLeave() {
+	page.reset()
+}
+
+func (page *SeedVerificationPage) reset() {
+	if page.seedPage.seed == nil {
+		return
+	}
+	for _, input := range page.seedPage.seed.verify {
+		input.editor.SetText("")
+	}
+}
+
+// HandleEvent moves between the verification editors on Tab and Shift+Tab.
+func (page *SeedVerificationPage) HandleEvent(e key.Event) bool {
+	tab, backwards := isTab(e)
+	if !tab || page.seedPage.seed == nil {
+		return false
+	}
+	moveFocus(page.seedPage.seed.editors(), backwards)
+	return true
+}
+
+// FocusedValue returns the word typed into the focused verification editor.
+func (page *SeedVerificationPage) FocusedValue() (string, bool) {
+	if page.seedPage.seed == nil {
+		return "", false
+	}
+	if editor := focusedEditor(page.seedPage.seed.editors()...); editor != nil {
+		return editor.Text(), true
+	}
+	return "", false
+}
+
+func (page *SeedVerificationPage) handleEvents() {
+	for page.backButtonWidget.Clicked() {
+		page.navigator.Back()
+	}
+
+	if page.seedPage.seed == nil {
+		return
+	}
+
+	for page.doVerifyButtonWidget.Clicked() {
+		page.doVerification()
+	}
+
+	if submitted(page.seedPage.seed.editors()...) {
+		page.doVerification()
+	}
+}
+
+func (page *SeedVerificationPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return page.renderInputColumns(gtx)
+			})
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(30)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return page.backButtonMaterial.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return page.doVerifyButtonMaterial.Layout(gtx)
+					}),
+				)
+			})
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *SeedVerificationPage) renderInputColumns(gtx layout.Context) layout.Dimensions {
+	if page.seedPage.seed == nil {
+		return layout.Dimensions{}
+	}
+
+	verify := page.seedPage.seed.verify
+	// the word is typed into an editor that leaves some room after it
+	cellWidth := wordCellWidth(page.theme, gtx, len(verify), longestSeedWord, unit.Dp(20))
+
+	return wordGrid.Layout(gtx, len(verify), cellWidth, func(gtx layout.Context, i int) layout.Dimensions {
+		input := verify[i]
+		return layout.Inset{
+			Bottom: unit.Dp(10),
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.theme.Body1(strconv.Itoa(input.index + 1)).Layout(gtx)
+				}),
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					gtx.Constraints.Min.X = gtx.Constraints.Max.X
+					return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, page.theme.Editor("", input.editor).Layout)
+				}),
+			)
+		})
+	})
+}
+
+func (page *SeedVerificationPage) doVerification() {
+	seed := page.seedPage.seed
+	for _, input := range seed.verify {
+		if seed.secret.Word(input.index) != input.editor.Text() {
+			page.notifier.Error(i18n.T("Invalid verification words. Please check the words and try again"))
+			return
+		}
+	}
+	page.notifier.Success(i18n.T("Verification successfull"))
+}
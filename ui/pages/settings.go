@@ -0,0 +1,315 @@
+package pages
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const SettingsPageID = "SettingsPage"
+
+type (
+	// settingOption is one of the values offered for a setting.
+	settingOption struct {
+		value string
+		label string
+	}
+
+	// settingGroup lets one of a few values be picked for a setting.
+	settingGroup struct {
+		label  material.LabelStyle
+		group  *widget.Enum
+		radios []theme.RadioButton
+	}
+
+	SettingsPage struct {
+		pageHooks
+
+		theme  *theme.Theme
+		config *helper.Config
+		onSave func(previous helper.Config)
+
+		headerLabel     material.LabelStyle
+		configFileLabel material.LabelStyle
+
+		networkGroup      *settingGroup
+		seedSizeGroup     *settingGroup
+		verificationGroup *settingGroup
+		exportFormatGroup *settingGroup
+		themeGroup        *settingGroup
+		languageGroup     *settingGroup
+		motionGroup       *settingGroup
+
+		recipientKeyEditorMaterial     theme.Editor
+		recipientKeyEditorWidget       *widget.Editor
+		exportDirEditorMaterial        theme.Editor
+		exportDirEditorWidget          *widget.Editor
+		clipboardTimeoutEditorMaterial theme.Editor
+		clipboardTimeoutEditorWidget   *widget.Editor
+
+		saveButtonMaterial     theme.Button
+		saveButtonWidget       *widget.Clickable
+		defaultsButtonMaterial theme.Button
+		defaultsButtonWidget   *widget.Clickable
+
+		notifier *notify.Notifier
+
+		list *layout.List
+	}
+)
+
+// the values of the page transitions setting
+const (
+	motionAnimated = "animated"
+	motionReduced  = "reduced"
+)
+
+// seedSizeOptions are the seed sizes offered, any size seedgen accepts can
+// still be set in the config file.
+var seedSizeOptions = []int{16, 32, 64}
+
+// NewSettingsPage returns a page for editing cfg. onSave is called with the
+// settings as they were before, after the new ones are saved to cfg and the
+// config file.
+func NewSettingsPage(th *theme.Theme, notifier *notify.Notifier, cfg *helper.Config, onSave func(previous helper.Config)) *SettingsPage {
+	page := &SettingsPage{
+		theme:    th,
+		notifier: notifier,
+		config:   cfg,
+		onSave:   onSave,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Settings"))
+	page.configFileLabel = th.Caption("")
+	page.configFileLabel.Color = th.Color.Hint
+	if filename, err := helper.ConfigFile(); err == nil {
+		page.configFileLabel.Text = i18n.T("Saved to %s", filename)
+	}
+
+	networks := make([]settingOption, len(seedgen.Networks))
+	for i, network := range seedgen.Networks {
+		networks[i] = settingOption{value: string(network), label: string(network)}
+	}
+	page.networkGroup = newSettingGroup(th, i18n.T("Default network"), networks)
+
+	seedSizes := make([]settingOption, len(seedSizeOptions))
+	for i, size := range seedSizeOptions {
+		seedSizes[i] = settingOption{
+			value: strconv.Itoa(size),
+			label: i18n.T("%d words (%d bytes)", size+1, size),
+		}
+	}
+	page.seedSizeGroup = newSettingGroup(th, i18n.T("Seed length"), seedSizes)
+
+	page.verificationGroup = newSettingGroup(th, i18n.T("Seed verification"), []settingOption{
+		{value: helper.VerifyAllWords, label: i18n.T("Every word")},
+		{value: helper.VerifySomeWords, label: i18n.N("%d random word", "%d random words", verifyWordCount, verifyWordCount)},
+	})
+
+	page.exportFormatGroup = newSettingGroup(th, i18n.T("Export format"), []settingOption{
+		{value: helper.ExportFormatCSV, label: "CSV"},
+		{value: helper.ExportFormatEncryptedCSV, label: i18n.T("Encrypted CSV")},
+	})
+
+	palettes := make([]settingOption, len(theme.Palettes))
+	for i, palette := range theme.Palettes {
+		palettes[i] = settingOption{value: palette.Name, label: i18n.T(palette.Title)}
+	}
+	page.themeGroup = newSettingGroup(th, i18n.T("Theme"), palettes)
+
+	languages := []settingOption{{value: "", label: i18n.T("System language")}}
+	for _, locale := range i18n.Locales {
+		languages = append(languages, settingOption{value: locale.Tag, label: locale.Name})
+	}
+	page.languageGroup = newSettingGroup(th, i18n.T("Language"), languages)
+
+	page.motionGroup = newSettingGroup(th, i18n.T("Page transitions"), []settingOption{
+		{value: motionAnimated, label: i18n.T("Animated")},
+		{value: motionReduced, label: i18n.T("Off")},
+	})
+
+	page.recipientKeyEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.recipientKeyEditorMaterial = th.Editor(i18n.T("Recipient public key for encrypted exports"), page.recipientKeyEditorWidget)
+
+	page.exportDirEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.exportDirEditorMaterial = th.Editor(i18n.T("Export location (default %s)", helper.DefaultExportDirectory()), page.exportDirEditorWidget)
+
+	page.clipboardTimeoutEditorWidget = &widget.Editor{
+		SingleLine: true,
+		Submit:     true,
+	}
+	page.clipboardTimeoutEditorMaterial = th.Editor(i18n.T("Clipboard timeout, e.g. 30s"), page.clipboardTimeoutEditorWidget)
+
+	page.saveButtonWidget = new(widget.Clickable)
+	page.saveButtonMaterial = th.Button(i18n.T("Save"), page.saveButtonWidget)
+
+	page.defaultsButtonWidget = new(widget.Clickable)
+	page.defaultsButtonMaterial = th.SecondaryButton(i18n.T("Restore Defaults"), page.defaultsButtonWidget)
+
+	return page
+}
+
+func newSettingGroup(th *theme.Theme, label string, options []settingOption) *settingGroup {
+	group := &settingGroup{
+		label:  th.Body1(label),
+		group:  new(widget.Enum),
+		radios: make([]theme.RadioButton, len(options)),
+	}
+	for i, option := range options {
+		group.radios[i] = th.RadioButton(option.value, option.label, group.group)
+		group.radios[i].Size = unit.Dp(20)
+	}
+	return group
+}
+
+func (group *settingGroup) Layout(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(group.label.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			list := layout.List{Axis: layout.Horizontal}
+			return list.Layout(gtx, len(group.radios), func(gtx layout.Context, index int) layout.Dimensions {
+				return layout.Inset{Right: unit.Dp(10), Top: unit.Dp(5)}.Layout(gtx, group.radios[index].Layout)
+			})
+		}),
+	)
+}
+
+func (page *SettingsPage) OnEnter() {
+	page.load(*page.config)
+}
+
+// load shows cfg in the form.
+func (page *SettingsPage) load(cfg helper.Config) {
+	page.networkGroup.group.Value = cfg.DefaultNetwork
+	page.seedSizeGroup.group.Value = strconv.Itoa(cfg.SeedSize)
+	page.verificationGroup.group.Value = cfg.VerificationMode
+	page.exportFormatGroup.group.Value = cfg.ExportFormat
+	page.themeGroup.group.Value = cfg.Theme
+	page.languageGroup.group.Value = cfg.Language
+	page.motionGroup.group.Value = motionAnimated
+	if cfg.ReduceMotion {
+		page.motionGroup.group.Value = motionReduced
+	}
+	page.recipientKeyEditorWidget.SetText(cfg.ExportRecipientKey)
+	page.exportDirEditorWidget.SetText(cfg.ExportDirectory)
+	page.clipboardTimeoutEditorWidget.SetText(time.Duration(cfg.ClipboardTimeout).String())
+}
+
+func (page *SettingsPage) handleEvents() {
+	for page.saveButtonWidget.Clicked() {
+		page.save()
+	}
+
+	if submitted(page.clipboardTimeoutEditorWidget) {
+		page.save()
+	}
+
+	for page.defaultsButtonWidget.Clicked() {
+		page.load(helper.DefaultConfig())
+		page.notifier.Info(i18n.T("Defaults restored, save to keep them"))
+	}
+}
+
+func (page *SettingsPage) save() {
+	cfg, err := page.readForm()
+	if err == nil {
+		err = helper.SaveConfig(&cfg)
+	}
+	if err != nil {
+		page.notifier.Error(i18n.T("Settings not saved: %s", err))
+		return
+	}
+
+	previous := *page.config
+	*page.config = cfg
+	page.notifier.Success(i18n.T("Settings saved"))
+	page.onSave(previous)
+}
+
+// readForm returns the settings in the form, they still have to be
+// validated.
+func (page *SettingsPage) readForm() (helper.Config, error) {
+	cfg := *page.config
+
+	seedSize, err := strconv.Atoi(page.seedSizeGroup.group.Value)
+	if err != nil {
+		return cfg, errors.New(i18n.T("choose a seed length"))
+	}
+	timeout, err := time.ParseDuration(strings.TrimSpace(page.clipboardTimeoutEditorWidget.Text()))
+	if err != nil {
+		return cfg, errors.New(i18n.T("clipboard timeout must be a duration such as 30s or 1m"))
+	}
+
+	cfg.DefaultNetwork = page.networkGroup.group.Value
+	cfg.SeedSize = seedSize
+	cfg.VerificationMode = page.verificationGroup.group.Value
+	cfg.ExportFormat = page.exportFormatGroup.group.Value
+	cfg.ExportRecipientKey = strings.TrimSpace(page.recipientKeyEditorWidget.Text())
+	cfg.ExportDirectory = strings.TrimSpace(page.exportDirEditorWidget.Text())
+	cfg.ClipboardTimeout = helper.Duration(timeout)
+	cfg.Theme = page.themeGroup.group.Value
+	cfg.Language = page.languageGroup.group.Value
+	cfg.ReduceMotion = page.motionGroup.group.Value == motionReduced
+	return cfg, nil
+}
+
+func (page *SettingsPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(page.headerLabel.Layout),
+				layout.Rigid(page.configFileLabel.Layout),
+			)
+		},
+		page.networkGroup.Layout,
+		page.seedSizeGroup.Layout,
+		page.verificationGroup.Layout,
+		page.exportFormatGroup.Layout,
+		page.recipientKeyEditorMaterial.Layout,
+		page.exportDirEditorMaterial.Layout,
+		page.clipboardTimeoutEditorMaterial.Layout,
+		page.themeGroup.Layout,
+		page.languageGroup.Layout,
+		page.motionGroup.Layout,
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(page.defaultsButtonMaterial.Layout),
+				layout.Rigid(page.saveButtonMaterial.Layout),
+			)
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+// FocusedValue returns the text of the focused editor.
+func (page *SettingsPage) FocusedValue() (string, bool) {
+	if editor := focusedEditor(page.recipientKeyEditorWidget, page.exportDirEditorWidget, page.clipboardTimeoutEditorWidget); editor != nil {
+		return editor.Text(), false
+	}
+	return "", false
+}
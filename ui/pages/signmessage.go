@@ -0,0 +1,381 @@
+package pages
+
+import (
+	"errors"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/atotto/clipboard"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+const (
+	SignMessagePageID = "SignMessagePage"
+
+	signMode   = "Sign"
+	verifyMode = "Verify"
+)
+
+type SignMessagePage struct {
+	pageHooks
+
+	theme       *theme.Theme
+	addressPage *AddressPage
+
+	headerLabel      material.LabelStyle
+	hintLabel        material.LabelStyle
+	signatureLabel   material.LabelStyle
+	copiedLabel      material.LabelStyle
+	hasCopiedMessage bool
+
+	modeGroup         *widget.Enum
+	modeRadioMaterial []theme.RadioButton
+
+	// pair is the generated key pair selected in the address table, it is
+	// signed with instead of the key typed in and only shown masked.
+	pair      *seedgen.KeyPair
+	keyReveal *theme.Reveal
+
+	typeKeyButtonMaterial theme.Button
+	typeKeyButtonWidget   *widget.Clickable
+
+	wifEditorMaterial       theme.Editor
+	wifEditorWidget         *widget.Editor
+	messageEditorMaterial   theme.Editor
+	messageEditorWidget     *widget.Editor
+	addressEditorMaterial   theme.Editor
+	addressEditorWidget     *widget.Editor
+	signatureEditorMaterial theme.Editor
+	signatureEditorWidget   *widget.Editor
+
+	signButtonMaterial   theme.Button
+	signButtonWidget     *widget.Clickable
+	verifyButtonMaterial theme.Button
+	verifyButtonWidget   *widget.Clickable
+
+	copyIconMaterial theme.IconButton
+	copyIconWidget   *widget.Clickable
+
+	signature string
+	notifier  *notify.Notifier
+
+	list *layout.List
+	err  error
+}
+
+// NewSignMessagePage returns a page for signing and verifying messages. The
+// address page is used to offer the keys it generated for signing.
+func NewSignMessagePage(th *theme.Theme, notifier *notify.Notifier, addressPage *AddressPage) *SignMessagePage {
+	page := &SignMessagePage{
+		theme:       th,
+		notifier:    notifier,
+		addressPage: addressPage,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Sign Message"))
+	page.hintLabel = th.Caption(i18n.T("Select a generated address to sign with its key"))
+	page.hintLabel.Color = th.Color.Hint
+	page.signatureLabel = th.Body1(i18n.T("Signature"))
+	page.copiedLabel = th.Caption(i18n.T("copied"))
+	page.copiedLabel.Color = th.Color.Success
+
+	modes := []string{signMode, verifyMode}
+	page.modeGroup = new(widget.Enum)
+	page.modeGroup.Value = signMode
+	page.modeRadioMaterial = make([]theme.RadioButton, len(modes))
+	for i := range modes {
+		page.modeRadioMaterial[i] = th.RadioButton(modes[i], i18n.T(modes[i]), page.modeGroup)
+		page.modeRadioMaterial[i].Size = unit.Dp(20)
+	}
+
+	page.keyReveal = theme.NewReveal(nil)
+	page.typeKeyButtonWidget = new(widget.Clickable)
+	page.typeKeyButtonMaterial = th.SecondaryButton(i18n.T("Type in a key"), page.typeKeyButtonWidget)
+
+	page.wifEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.wifEditorMaterial = th.Editor(i18n.T("Private key (WIF)"), page.wifEditorWidget)
+
+	page.messageEditorWidget = new(widget.Editor)
+	page.messageEditorMaterial = th.Editor(i18n.T("Message"), page.messageEditorWidget)
+
+	page.addressEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.addressEditorMaterial = th.Editor(i18n.T("Address"), page.addressEditorWidget)
+
+	page.signatureEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.signatureEditorMaterial = th.Editor(i18n.T("Signature"), page.signatureEditorWidget)
+
+	page.signButtonWidget = new(widget.Clickable)
+	page.signButtonMaterial = th.Button(i18n.T("Sign"), page.signButtonWidget)
+
+	page.verifyButtonWidget = new(widget.Clickable)
+	page.verifyButtonMaterial = th.Button(i18n.T("Verify"), page.verifyButtonWidget)
+
+	page.copyIconWidget = new(widget.Clickable)
+	page.copyIconMaterial = th.IconButton(theme.MustIcon(theme.NewIcon(icons.ContentContentCopy)), page.copyIconWidget)
+	page.copyIconMaterial.Background = th.Color.Background
+	page.copyIconMaterial.Color = th.Color.Text
+	page.copyIconMaterial.Size = unit.Dp(25)
+	page.copyIconMaterial.Padding = unit.Dp(5)
+
+	return page
+}
+
+// OnEnter starts over, signing with the pair selected in the address table
+// if there is one.
+func (page *SignMessagePage) OnEnter() {
+	page.reset()
+	page.clearKey()
+	page.pair = page.addressPage.selectedPair()
+}
+
+// OnLeave forgets the private key typed in or selected so that it doesn't
+// stay in memory.
+func (page *SignMessagePage) OnLeave() {
+	page.reset()
+	page.clearKey()
+}
+
+func (page *SignMessagePage) clearKey() {
+	page.wifEditorWidget.SetText("")
+	page.pair = nil
+	page.keyReveal.Hide()
+}
+
+func (page *SignMessagePage) reset() {
+	page.err = nil
+	page.signature = ""
+	page.hasCopiedMessage = false
+}
+
+func (page *SignMessagePage) handleEvents() {
+	if page.modeGroup.Changed() {
+		page.reset()
+	}
+
+	for page.typeKeyButtonWidget.Clicked() {
+		page.reset()
+		page.clearKey()
+	}
+
+	for page.signButtonWidget.Clicked() {
+		page.sign()
+	}
+
+	for page.verifyButtonWidget.Clicked() {
+		page.verify()
+	}
+
+	for page.copyIconWidget.Clicked() {
+		clipboard.WriteAll(page.signature)
+		page.hasCopiedMessage = true
+	}
+}
+
+// FocusedValue returns the text of the focused editor, or the signature once
+// a message has been signed. The private key editor holds a secret.
+func (page *SignMessagePage) FocusedValue() (string, bool) {
+	editor := focusedEditor(page.wifEditorWidget, page.messageEditorWidget, page.addressEditorWidget, page.signatureEditorWidget)
+	switch {
+	case editor == page.wifEditorWidget:
+		return editor.Text(), true
+	case editor != nil:
+		return editor.Text(), false
+	default:
+		return page.signature, false
+	}
+}
+
+func (page *SignMessagePage) sign() {
+	page.reset()
+
+	var signature string
+	var err error
+	message := page.messageEditorWidget.Text()
+	if page.pair != nil {
+		signature, err = page.pair.SignMessage(message)
+	} else {
+		wif := page.wifEditorWidget.Text()
+		if wif == "" {
+			page.err = errors.New(i18n.T("Please type in or select the private key to sign with"))
+			return
+		}
+		signature, err = seedgen.SignMessage(wif, message)
+	}
+	if err != nil {
+		page.err = errors.New(i18n.T("error signing message: %s", err))
+		return
+	}
+	page.signature = signature
+}
+
+func (page *SignMessagePage) verify() {
+	page.reset()
+
+	address := page.addressEditorWidget.Text()
+	signature := page.signatureEditorWidget.Text()
+	if address == "" || signature == "" {
+		page.err = errors.New(i18n.T("Please type in the address and the signature to verify"))
+		return
+	}
+
+	valid, err := seedgen.VerifyMessage(address, page.messageEditorWidget.Text(), signature)
+	if err != nil {
+		page.err = errors.New(i18n.T("error verifying message: %s", err))
+		return
+	}
+
+	if valid {
+		page.notifier.Success(i18n.T("The signature is valid for this address and message"))
+	} else {
+		page.notifier.Error(i18n.T("The signature is not valid for this address and message"))
+	}
+}
+
+func (page *SignMessagePage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			list := layout.List{Axis: layout.Horizontal}
+			return list.Layout(gtx, len(page.modeRadioMaterial), func(gtx layout.Context, index int) layout.Dimensions {
+				return layout.Inset{Right: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return page.modeRadioMaterial[index].Layout(gtx)
+				})
+			})
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+	}
+
+	if page.modeGroup.Value == verifyMode {
+		w = append(w, page.verifyWidgets()...)
+	} else {
+		w = append(w, page.signWidgets()...)
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *SignMessagePage) signWidgets() []layout.Widget {
+	return []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			if page.pair != nil {
+				return page.renderSelectedKey(gtx)
+			}
+			return page.renderKeyEditor(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.messageEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.signature == "" {
+				return layout.Dimensions{}
+			}
+			return page.renderSignature(gtx)
+		},
+	}
+}
+
+func (page *SignMessagePage) verifyWidgets() []layout.Widget {
+	return []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.addressEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.messageEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signatureEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.verifyButtonMaterial.Layout(gtx)
+		},
+	}
+}
+
+// renderSelectedKey shows the address of the pair selected in the address
+// table with its private key masked.
+func (page *SignMessagePage) renderSelectedKey(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return page.theme.Body1(i18n.T("Signing with the generated key of %s", page.pair.Address)).Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(5), Bottom: unit.Dp(5)}.Layout(gtx,
+				page.theme.MaskedLabel(page.theme.Caption(""), page.pair.WIF, page.keyReveal).Layout)
+		}),
+		layout.Rigid(page.typeKeyButtonMaterial.Layout),
+	)
+}
+
+// renderKeyEditor shows the editor for the private key, with a hint at
+// selecting a generated address instead if there are any.
+func (page *SignMessagePage) renderKeyEditor(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(page.wifEditorMaterial.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if len(page.addressPage.generatedPairs) == 0 {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Top: unit.Dp(5)}.Layout(gtx, page.hintLabel.Layout)
+		}),
+	)
+}
+
+func (page *SignMessagePage) renderSignature(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return page.signatureLabel.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.theme.Body2(page.signature).Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Left: unit.Dp(7)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return page.copyIconMaterial.Layout(gtx)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if page.hasCopiedMessage {
+						return layout.Inset{Left: unit.Dp(5), Top: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							return page.copiedLabel.Layout(gtx)
+						})
+					}
+					return layout.Dimensions{}
+				}),
+			)
+		}),
+	)
+}
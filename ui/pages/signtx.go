@@ -0,0 +1,347 @@
+package pages
+
+import (
+	"errors"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/atotto/clipboard"
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+const (
+	SignTransactionPageID = "SignTransactionPage"
+
+	qrCodeSize = 300
+)
+
+// signedTransactionView shows a signed transaction as hex with a copy
+// button and as a QR code for transferring it off an offline machine.
+type signedTransactionView struct {
+	theme *theme.Theme
+
+	signedLabel      material.LabelStyle
+	copiedLabel      material.LabelStyle
+	hasCopiedMessage bool
+
+	copyIconMaterial theme.IconButton
+	copyIconWidget   *widget.Clickable
+
+	signedTx *seedgen.SignedTransaction
+	qrCode   *widget.Image
+}
+
+type SignTransactionPage struct {
+	pageHooks
+
+	theme *theme.Theme
+
+	headerLabel material.LabelStyle
+
+	txEditorMaterial          theme.Editor
+	txEditorWidget            *widget.Editor
+	prevOutputsEditorMaterial theme.Editor
+	prevOutputsEditorWidget   *widget.Editor
+	keysEditorMaterial        theme.Editor
+	keysEditorWidget          *widget.Editor
+	exportEditorMaterial      theme.Editor
+	exportEditorWidget        *widget.Editor
+
+	loadKeysButtonMaterial theme.Button
+	loadKeysButtonWidget   *widget.Clickable
+	signButtonMaterial     theme.Button
+	signButtonWidget       *widget.Clickable
+
+	loadedKeys []string
+
+	signedTxView *signedTransactionView
+	notifier     *notify.Notifier
+
+	list *layout.List
+	err  error
+}
+
+func NewSignTransactionPage(th *theme.Theme, notifier *notify.Notifier) *SignTransactionPage {
+	page := &SignTransactionPage{
+		theme:    th,
+		notifier: notifier,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Sign Transaction"))
+	page.signedTxView = newSignedTransactionView(th)
+
+	page.txEditorWidget = new(widget.Editor)
+	page.txEditorMaterial = th.Editor(i18n.T("Unsigned transaction (hex)"), page.txEditorWidget)
+
+	page.prevOutputsEditorWidget = new(widget.Editor)
+	page.prevOutputsEditorMaterial = th.Editor(i18n.T("Previous outputs, one per input: <script hex> <amount in DCR>"), page.prevOutputsEditorWidget)
+
+	page.keysEditorWidget = new(widget.Editor)
+	page.keysEditorMaterial = th.Editor(i18n.T("Private keys (WIF), one per line"), page.keysEditorWidget)
+
+	page.exportEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.exportEditorMaterial = th.Editor(i18n.T("Path to a dcrseedgen export"), page.exportEditorWidget)
+
+	page.loadKeysButtonWidget = new(widget.Clickable)
+	page.loadKeysButtonMaterial = th.SecondaryButton(i18n.T("Load Keys"), page.loadKeysButtonWidget)
+
+	page.signButtonWidget = new(widget.Clickable)
+	page.signButtonMaterial = th.Button(i18n.T("Sign"), page.signButtonWidget)
+
+	return page
+}
+
+func newSignedTransactionView(th *theme.Theme) *signedTransactionView {
+	view := &signedTransactionView{
+		theme: th,
+	}
+
+	view.signedLabel = th.Body1(i18n.T("Signed Transaction"))
+	view.copiedLabel = th.Caption(i18n.T("copied"))
+	view.copiedLabel.Color = th.Color.Success
+
+	view.copyIconWidget = new(widget.Clickable)
+	view.copyIconMaterial = th.IconButton(theme.MustIcon(theme.NewIcon(icons.ContentContentCopy)), view.copyIconWidget)
+	view.copyIconMaterial.Background = th.Color.Background
+	view.copyIconMaterial.Color = th.Color.Text
+	view.copyIconMaterial.Size = unit.Dp(25)
+	view.copyIconMaterial.Padding = unit.Dp(5)
+
+	return view
+}
+
+// setTransaction shows signedTx, or nothing if it is nil. It fails if the
+// transaction does not fit in a QR code, the hex is shown regardless.
+func (view *signedTransactionView) setTransaction(signedTx *seedgen.SignedTransaction) error {
+	view.signedTx = signedTx
+	view.qrCode = nil
+	view.hasCopiedMessage = false
+	if signedTx == nil {
+		return nil
+	}
+
+	qrCode, err := theme.QRCode(strings.ToUpper(signedTx.Hex), qrCodeSize)
+	if err != nil {
+		return errors.New(i18n.T("transaction is too large to show as a QR code"))
+	}
+	view.qrCode = &qrCode
+	return nil
+}
+
+// hex returns the signed transaction hex, or "" if nothing was signed yet.
+func (view *signedTransactionView) hex() string {
+	if view.signedTx == nil {
+		return ""
+	}
+	return view.signedTx.Hex
+}
+
+func (view *signedTransactionView) handleEvents() {
+	for view.copyIconWidget.Clicked() {
+		clipboard.WriteAll(view.signedTx.Hex)
+		view.hasCopiedMessage = true
+	}
+}
+
+func (view *signedTransactionView) Layout(gtx layout.Context) layout.Dimensions {
+	if view.signedTx == nil {
+		return layout.Dimensions{}
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return view.signedLabel.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return view.theme.Body2(view.signedTx.Hex).Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							return layout.Inset{Left: unit.Dp(7)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								return view.copyIconMaterial.Layout(gtx)
+							})
+						}),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if view.hasCopiedMessage {
+								return layout.Inset{Top: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+									return view.copiedLabel.Layout(gtx)
+								})
+							}
+							return layout.Dimensions{}
+						}),
+					)
+				}),
+			)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if view.qrCode == nil {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Top: unit.Dp(10)}.Layout(gtx, view.qrCode.Layout)
+		}),
+	)
+}
+
+func (page *SignTransactionPage) OnEnter() {
+	page.reset()
+	page.clearKeys()
+}
+
+// OnLeave clears the private keys typed in or loaded so that they don't stay
+// in memory.
+func (page *SignTransactionPage) OnLeave() {
+	page.reset()
+	page.clearKeys()
+}
+
+func (page *SignTransactionPage) clearKeys() {
+	page.loadedKeys = nil
+	page.keysEditorWidget.SetText("")
+}
+
+func (page *SignTransactionPage) reset() {
+	page.err = nil
+	page.signedTxView.setTransaction(nil)
+}
+
+func (page *SignTransactionPage) handleEvents() {
+	for page.loadKeysButtonWidget.Clicked() {
+		page.loadKeys()
+	}
+
+	for page.signButtonWidget.Clicked() {
+		page.sign()
+	}
+
+	page.signedTxView.handleEvents()
+}
+
+// FocusedValue returns the text of the focused editor, or the signed
+// transaction once there is one. The private keys editor holds secrets.
+func (page *SignTransactionPage) FocusedValue() (string, bool) {
+	editor := focusedEditor(page.txEditorWidget, page.prevOutputsEditorWidget, page.keysEditorWidget, page.exportEditorWidget)
+	switch {
+	case editor == page.keysEditorWidget:
+		return editor.Text(), true
+	case editor != nil:
+		return editor.Text(), false
+	default:
+		return page.signedTxView.hex(), false
+	}
+}
+
+func (page *SignTransactionPage) loadKeys() {
+	page.reset()
+
+	filename := page.exportEditorWidget.Text()
+	if filename == "" {
+		page.err = errors.New(i18n.T("Please type in the path to the export to load keys from"))
+		return
+	}
+
+	keys, err := helper.ReadPrivateKeysCSV(filename)
+	if err != nil {
+		page.err = errors.New(i18n.T("error loading keys: %s", err))
+		return
+	}
+
+	page.loadedKeys = keys
+	page.notifier.Success(i18n.N("Loaded %d private key from the export", "Loaded %d private keys from the export", len(keys), len(keys)))
+}
+
+func (page *SignTransactionPage) sign() {
+	page.reset()
+
+	txHex := page.txEditorWidget.Text()
+	if txHex == "" {
+		page.err = errors.New(i18n.T("Please type in the unsigned transaction"))
+		return
+	}
+
+	prevOutputs, err := seedgen.ParsePrevOutputs(page.prevOutputsEditorWidget.Text())
+	if err != nil {
+		page.err = errors.New(i18n.T("error reading previous outputs: %s", err))
+		return
+	}
+
+	keys := append(seedgen.ParsePrivateKeys(page.keysEditorWidget.Text()), page.loadedKeys...)
+	signedTx, err := seedgen.SignTransaction(txHex, prevOutputs, keys)
+	if err != nil {
+		page.err = errors.New(i18n.T("error signing transaction: %s", err))
+		return
+	}
+	if signedTx.SignedInputs < signedTx.TotalInputs {
+		page.notifier.Warning(i18n.T("Signed %d of %d inputs, the remaining inputs need other keys",
+			signedTx.SignedInputs, signedTx.TotalInputs))
+	} else {
+		page.notifier.Success(i18n.T("Signed all %d inputs", signedTx.TotalInputs))
+	}
+
+	page.err = page.signedTxView.setTransaction(signedTx)
+}
+
+func (page *SignTransactionPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.txEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.prevOutputsEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.keysEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Right: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return page.exportEditorMaterial.Layout(gtx)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.loadKeysButtonMaterial.Layout(gtx)
+				}),
+			)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signedTxView.Layout(gtx)
+		},
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
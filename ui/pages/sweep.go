@@ -0,0 +1,305 @@
+package pages
+
+import (
+	"errors"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+const SweepPageID = "SweepPage"
+
+type SweepPage struct {
+	pageHooks
+
+	theme *theme.Theme
+
+	headerLabel material.LabelStyle
+	reviewLabel material.LabelStyle
+
+	utxoFileEditorMaterial    theme.Editor
+	utxoFileEditorWidget      *widget.Editor
+	destinationEditorMaterial theme.Editor
+	destinationEditorWidget   *widget.Editor
+	feeRateEditorMaterial     theme.Editor
+	feeRateEditorWidget       *widget.Editor
+	keysEditorMaterial        theme.Editor
+	keysEditorWidget          *widget.Editor
+	exportEditorMaterial      theme.Editor
+	exportEditorWidget        *widget.Editor
+
+	buildButtonMaterial    theme.Button
+	buildButtonWidget      *widget.Clickable
+	loadKeysButtonMaterial theme.Button
+	loadKeysButtonWidget   *widget.Clickable
+	signButtonMaterial     theme.Button
+	signButtonWidget       *widget.Clickable
+
+	loadedKeys []string
+
+	sweep        *seedgen.Sweep
+	signedTxView *signedTransactionView
+	notifier     *notify.Notifier
+
+	list *layout.List
+	err  error
+}
+
+func NewSweepPage(th *theme.Theme, notifier *notify.Notifier) *SweepPage {
+	page := &SweepPage{
+		theme:    th,
+		notifier: notifier,
+	}
+
+	page.list = &layout.List{
+		Axis: layout.Vertical,
+	}
+
+	page.headerLabel = th.H5(i18n.T("Sweep Paper Key"))
+	page.reviewLabel = th.Body1(i18n.T("Review"))
+	page.signedTxView = newSignedTransactionView(th)
+
+	page.utxoFileEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.utxoFileEditorMaterial = th.Editor(i18n.T("Path to UTXO file (txid,vout,amount,script)"), page.utxoFileEditorWidget)
+
+	page.destinationEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.destinationEditorMaterial = th.Editor(i18n.T("Destination address"), page.destinationEditorWidget)
+
+	page.feeRateEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.feeRateEditorMaterial = th.Editor(i18n.T("Fee rate (DCR/kB)"), page.feeRateEditorWidget)
+	page.feeRateEditorWidget.SetText(seedgen.DefaultSweepFeeRate)
+
+	page.keysEditorWidget = new(widget.Editor)
+	page.keysEditorMaterial = th.Editor(i18n.T("Private keys (WIF), one per line"), page.keysEditorWidget)
+
+	page.exportEditorWidget = &widget.Editor{
+		SingleLine: true,
+	}
+	page.exportEditorMaterial = th.Editor(i18n.T("Path to a dcrseedgen export"), page.exportEditorWidget)
+
+	page.buildButtonWidget = new(widget.Clickable)
+	page.buildButtonMaterial = th.Button(i18n.T("Build"), page.buildButtonWidget)
+
+	page.loadKeysButtonWidget = new(widget.Clickable)
+	page.loadKeysButtonMaterial = th.SecondaryButton(i18n.T("Load Keys"), page.loadKeysButtonWidget)
+
+	page.signButtonWidget = new(widget.Clickable)
+	page.signButtonMaterial = th.SuccessButton(i18n.T("Sign"), page.signButtonWidget)
+
+	return page
+}
+
+func (page *SweepPage) OnEnter() {
+	page.clear()
+}
+
+// OnLeave clears the private keys typed in or loaded and the sweep signed
+// with them so that they don't stay in memory.
+func (page *SweepPage) OnLeave() {
+	page.clear()
+}
+
+func (page *SweepPage) clear() {
+	page.err = nil
+
+	page.sweep = nil
+	page.signedTxView.setTransaction(nil)
+	page.loadedKeys = nil
+	page.keysEditorWidget.SetText("")
+}
+
+func (page *SweepPage) handleEvents() {
+	for page.buildButtonWidget.Clicked() {
+		page.build()
+	}
+
+	for page.loadKeysButtonWidget.Clicked() {
+		page.loadKeys()
+	}
+
+	for page.signButtonWidget.Clicked() {
+		page.sign()
+	}
+
+	page.signedTxView.handleEvents()
+}
+
+// FocusedValue returns the text of the focused editor, or the signed
+// transaction once there is one. The private keys editor holds secrets.
+func (page *SweepPage) FocusedValue() (string, bool) {
+	editor := focusedEditor(page.utxoFileEditorWidget, page.destinationEditorWidget, page.feeRateEditorWidget, page.keysEditorWidget, page.exportEditorWidget)
+	switch {
+	case editor == page.keysEditorWidget:
+		return editor.Text(), true
+	case editor != nil:
+		return editor.Text(), false
+	default:
+		return page.signedTxView.hex(), false
+	}
+}
+
+func (page *SweepPage) build() {
+	page.err = nil
+	page.sweep = nil
+	page.signedTxView.setTransaction(nil)
+
+	filename := page.utxoFileEditorWidget.Text()
+	if filename == "" {
+		page.err = errors.New(i18n.T("Please type in the path to the UTXO file"))
+		return
+	}
+
+	utxos, err := helper.ReadUTXOFile(filename)
+	if err != nil {
+		page.err = errors.New(i18n.T("error reading UTXO file: %s", err))
+		return
+	}
+
+	sweep, err := seedgen.BuildSweep(utxos, page.destinationEditorWidget.Text(), page.feeRateEditorWidget.Text())
+	if err != nil {
+		page.err = errors.New(i18n.T("error building sweep: %s", err))
+		return
+	}
+	page.sweep = sweep
+}
+
+func (page *SweepPage) loadKeys() {
+	page.err = nil
+
+	filename := page.exportEditorWidget.Text()
+	if filename == "" {
+		page.err = errors.New(i18n.T("Please type in the path to the export to load keys from"))
+		return
+	}
+
+	keys, err := helper.ReadPrivateKeysCSV(filename)
+	if err != nil {
+		page.err = errors.New(i18n.T("error loading keys: %s", err))
+		return
+	}
+
+	page.loadedKeys = keys
+	page.notifier.Success(i18n.N("Loaded %d private key from the export", "Loaded %d private keys from the export", len(keys), len(keys)))
+}
+
+func (page *SweepPage) sign() {
+	page.err = nil
+
+	keys := append(seedgen.ParsePrivateKeys(page.keysEditorWidget.Text()), page.loadedKeys...)
+	signedTx, err := page.sweep.Sign(keys)
+	if err != nil {
+		page.err = errors.New(i18n.T("error signing sweep: %s", err))
+		return
+	}
+	page.err = page.signedTxView.setTransaction(signedTx)
+}
+
+func (page *SweepPage) Render(gtx layout.Context) layout.Dimensions {
+	page.handleEvents()
+
+	w := []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.headerLabel.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.utxoFileEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.destinationEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.feeRateEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.buildButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			if page.err != nil {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return page.theme.ErrorAlert(gtx, page.err.Error())
+			}
+			return layout.Dimensions{}
+		},
+	}
+
+	if page.sweep != nil {
+		w = append(w, page.signWidgets()...)
+	}
+
+	return page.list.Layout(gtx, len(w), func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(10)).Layout(gtx, w[i])
+	})
+}
+
+func (page *SweepPage) signWidgets() []layout.Widget {
+	return []layout.Widget{
+		func(gtx layout.Context) layout.Dimensions {
+			return page.renderReview(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.keysEditorMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Right: unit.Dp(10)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return page.exportEditorMaterial.Layout(gtx)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return page.loadKeysButtonMaterial.Layout(gtx)
+				}),
+			)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signButtonMaterial.Layout(gtx)
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return page.signedTxView.Layout(gtx)
+		},
+	}
+}
+
+func (page *SweepPage) renderReview(gtx layout.Context) layout.Dimensions {
+	fields := [][2]string{
+		{i18n.T("Destination"), page.sweep.Destination},
+		{i18n.T("Total input"), page.sweep.InputAmount.String()},
+		{i18n.T("Fee"), page.sweep.Fee.String()},
+		{i18n.T("Output amount"), page.sweep.OutputAmount.String()},
+		{i18n.T("Estimated size"), i18n.N("%d byte", "%d bytes", page.sweep.EstimatedSize, page.sweep.EstimatedSize)},
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, page.reviewLabel.Layout)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			list := layout.List{Axis: layout.Vertical}
+			return list.Layout(gtx, len(fields), func(gtx layout.Context, i int) layout.Dimensions {
+				title := page.theme.Body2(fields[i][0])
+				title.Color = page.theme.Color.Hint
+
+				return layout.Inset{Bottom: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						layout.Flexed(inspectionKeyWidth, title.Layout),
+						layout.Flexed(inspectionValueWidth, page.theme.Body2(fields[i][1]).Layout),
+					)
+				})
+			})
+		}),
+	)
+}
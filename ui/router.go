@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"gioui.org/io/key"
+	"gioui.org/layout"
+
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+type Page interface {
+	// OnEnter is called when the page is opened, before it is first drawn.
+	OnEnter()
+	// OnLeave is called when the page is closed, either by going back from
+	// it or by switching to another tab. Pages opened on top of it don't
+	// close it.
+	OnLeave()
+	// HandleEvent handles a key pressed while the page is shown and reports
+	// whether it did, keys it doesn't handle go to the window's shortcuts.
+	HandleEvent(e key.Event) bool
+	Render(layout.Context) layout.Dimensions
+}
+
+// ValueCopier is implemented by pages with something to copy on Ctrl+C. It
+// returns the value and whether it is a secret, secrets are taken off the
+// clipboard again after a timeout.
+type ValueCopier interface {
+	FocusedValue() (value string, secret bool)
+}
+
+// Router shows one page out of a stack of pages. The page at the bottom of
+// the stack belongs to the selected tab, sub-pages such as the seed
+// verification are pushed on top of it and closed again with Back.
+type Router struct {
+	theme  *theme.Theme
+	pages  map[string]Page
+	stack  []string
+	slider theme.Slider
+}
+
+func NewRouter(th *theme.Theme) *Router {
+	return &Router{
+		theme: th,
+		pages: make(map[string]Page),
+	}
+}
+
+// SetPages replaces the pages the router opens, e.g. after they were built
+// again. Sub-pages are closed and the page at the bottom of the stack is
+// opened anew.
+func (router *Router) SetPages(pages map[string]Page) {
+	root := router.Root()
+	router.leaveAll()
+	router.pages = pages
+	if root != "" {
+		router.Reset(root)
+	}
+}
+
+// Reset closes every open page and opens the page called id instead, as
+// when switching tabs.
+func (router *Router) Reset(id string) {
+	page, ok := router.pages[id]
+	if !ok {
+		return
+	}
+	router.leaveAll()
+	router.stack = []string{id}
+	page.OnEnter()
+}
+
+// Push opens the page called id on top of the current one.
+func (router *Router) Push(id string) {
+	page, ok := router.pages[id]
+	if !ok {
+		return
+	}
+	router.theme.Slide(&router.slider, true)
+	router.stack = append(router.stack, id)
+	page.OnEnter()
+}
+
+// Back closes the current page and returns to the one below it. It returns
+// false if there is nothing to go back to.
+func (router *Router) Back() bool {
+	if len(router.stack) < 2 {
+		return false
+	}
+	router.theme.Slide(&router.slider, false)
+	router.leave(len(router.stack) - 1)
+	router.stack = router.stack[:len(router.stack)-1]
+	return true
+}
+
+// Current returns the page being shown, or nil if none was opened yet.
+func (router *Router) Current() Page {
+	if len(router.stack) == 0 {
+		return nil
+	}
+	return router.pages[router.stack[len(router.stack)-1]]
+}
+
+// Root returns the id of the page at the bottom of the stack.
+func (router *Router) Root() string {
+	if len(router.stack) == 0 {
+		return ""
+	}
+	return router.stack[0]
+}
+
+func (router *Router) leave(index int) {
+	if page, ok := router.pages[router.stack[index]]; ok {
+		page.OnLeave()
+	}
+}
+
+// leaveAll closes the open pages, the top one first.
+func (router *Router) leaveAll() {
+	for i := len(router.stack) - 1; i >= 0; i-- {
+		router.leave(i)
+	}
+	router.stack = nil
+}
+
+// Layout draws the current page, sliding between pages as they are opened
+// and closed.
+func (router *Router) Layout(gtx layout.Context) layout.Dimensions {
+	page := router.Current()
+	if page == nil {
+		return layout.Dimensions{}
+	}
+	return router.slider.Layout(gtx, page.Render)
+}
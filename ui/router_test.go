@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+
+	"github.com/raedahgroup/dcrseedgen/ui/theme"
+)
+
+// fakePage records when it is opened and closed in calls.
+type fakePage struct {
+	id    string
+	calls *[]string
+}
+
+func (page fakePage) OnEnter() { *page.calls = append(*page.calls, "enter "+page.id) }
+func (page fakePage) OnLeave() { *page.calls = append(*page.calls, "leave "+page.id) }
+
+func (fakePage) HandleEvent(key.Event) bool { return false }
+
+func (fakePage) Render(layout.Context) layout.Dimensions { return layout.Dimensions{} }
+
+func testRouter(calls *[]string, ids ...string) *Router {
+	pages := make(map[string]Page)
+	for _, id := range ids {
+		pages[id] = fakePage{id: id, calls: calls}
+	}
+	router := NewRouter(&theme.Theme{})
+	router.SetPages(pages)
+	return router
+}
+
+func TestRouter(t *testing.T) {
+	tests := []struct {
+		name      string
+		run       func(router *Router)
+		wantCalls []string
+		wantStack []string
+	}{
+		{
+			name:      "reset",
+			run:       func(router *Router) { router.Reset("a") },
+			wantCalls: []string{"enter a"},
+			wantStack: []string{"a"},
+		},
+		{
+			name: "back on the root page",
+			run: func(router *Router) {
+				router.Reset("a")
+				router.Back()
+			},
+			wantCalls: []string{"enter a"},
+			wantStack: []string{"a"},
+		},
+		{
+			name: "push keeps the page below open",
+			run: func(router *Router) {
+				router.Reset("a")
+				router.Push("b")
+			},
+			wantCalls: []string{"enter a", "enter b"},
+			wantStack: []string{"a", "b"},
+		},
+		{
+			name: "back closes only the top page",
+			run: func(router *Router) {
+				router.Reset("a")
+				router.Push("b")
+				router.Back()
+			},
+			wantCalls: []string{"enter a", "enter b", "leave b"},
+			wantStack: []string{"a"},
+		},
+		{
+			name: "reset closes every page, the top one first",
+			run: func(router *Router) {
+				router.Reset("a")
+				router.Push("b")
+				router.Reset("c")
+			},
+			wantCalls: []string{"enter a", "enter b", "leave b", "leave a", "enter c"},
+			wantStack: []string{"c"},
+		},
+		{
+			name: "unknown pages are ignored",
+			run: func(router *Router) {
+				router.Reset("a")
+				router.Push("missing")
+				router.Reset("missing")
+			},
+			wantCalls: []string{"enter a"},
+			wantStack: []string{"a"},
+		},
+	}
+
+	for _, test := range tests {
+		var calls []string
+		router := testRouter(&calls, "a", "b", "c")
+		test.run(router)
+
+		if !reflect.DeepEqual(calls, test.wantCalls) {
+			t.Errorf("%s: calls: got %v, want %v", test.name, calls, test.wantCalls)
+		}
+		stack := append([]string{}, router.stack...)
+		if !reflect.DeepEqual(stack, test.wantStack) {
+			t.Errorf("%s: stack: got %v, want %v", test.name, stack, test.wantStack)
+		}
+	}
+}
+
+func TestRouterBackOnEmptyStack(t *testing.T) {
+	var calls []string
+	router := testRouter(&calls, "a")
+
+	if router.Back() {
+		t.Error("went back with no page open")
+	}
+	if router.Current() != nil || router.Root() != "" {
+		t.Errorf("got current page %v and root %q, want none", router.Current(), router.Root())
+	}
+	if len(calls) != 0 {
+		t.Errorf("calls: got %v, want none", calls)
+	}
+}
+
+func TestRouterSetPages(t *testing.T) {
+	var calls []string
+	router := testRouter(&calls, "a", "b")
+	router.Reset("a")
+	router.Push("b")
+
+	var newCalls []string
+	router.SetPages(map[string]Page{
+		"a": fakePage{id: "new a", calls: &newCalls},
+		"b": fakePage{id: "new b", calls: &newCalls},
+	})
+
+	if want := []string{"enter a", "enter b", "leave b", "leave a"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("old pages: got %v, want %v", calls, want)
+	}
+	if want := []string{"enter new a"}; !reflect.DeepEqual(newCalls, want) {
+		t.Errorf("new pages: got %v, want %v", newCalls, want)
+	}
+	if router.Root() != "a" || router.Current() != router.pages["a"] {
+		t.Errorf("got root %q, want the new page a open", router.Root())
+	}
+}
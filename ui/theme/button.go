@@ -37,6 +37,9 @@ func (t *Theme) Button(txt string, clickable *widget.Clickable) Button {
 	btn := Button{
 		btn: material.Button(t.Theme, clickable, txt),
 	}
+	// material buttons always have white text, which can't be read on the
+	// light accents of the high contrast palette
+	btn.btn.Color = t.Color.InvText
 	btn.btn.Background = t.Color.Primary
 	return btn
 }
@@ -145,7 +148,7 @@ type IconButton struct {
 func (t *Theme) Button(txt string) Button {
 	return Button{
 		Text:       txt,
-		Color:      rgb(0xffffff),
+		Color:      t.Color.InvText,
 		Background: t.Color.Primary,
 		TextSize:   t.TextSize.Scale(14.0 / 16.0),
 		Inset: layout.Inset{
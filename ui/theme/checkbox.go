@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package theme
+
+import (
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+type CheckBox struct {
+	material.CheckBoxStyle
+}
+
+// CheckBox returns a CheckBox with a label that toggles the Bool.
+func (t *Theme) CheckBox(label string, checkBox *widget.Bool) CheckBox {
+	return CheckBox{
+		material.CheckBox(t.Theme, checkBox, label),
+	}
+}
+
+func (c CheckBox) Layout(gtx layout.Context) layout.Dimensions {
+	return c.CheckBoxStyle.Layout(gtx)
+}
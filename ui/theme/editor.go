@@ -15,23 +15,21 @@ import (
 
 type Editor struct {
 	material.EditorStyle
-	lineColor color.RGBA
+	lineColor  color.RGBA
+	focusColor color.RGBA
 }
 
 func (t *Theme) Editor(hint string, e *widget.Editor) Editor {
 	return Editor{
 		EditorStyle: material.Editor(t.Theme, e, hint),
 		lineColor:   t.Color.Hint,
+		focusColor:  t.Color.Focus,
 	}
 }
 
 func (e Editor) Layout(gtx layout.Context) layout.Dimensions {
-	col := e.lineColor
-
 	if e.Editor.Focused() {
-		e.lineColor = color.RGBA{41, 112, 255, 255}
-	} else {
-		e.lineColor = col
+		e.lineColor = e.focusColor
 	}
 
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
@@ -0,0 +1,105 @@
+package theme
+
+import (
+	"image"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// TextWidth returns how many pixels wide txt is when drawn at size, e.g. to
+// work out how many columns of words fit next to each other.
+func (t *Theme) TextWidth(gtx layout.Context, size unit.Value, txt string) int {
+	lines := t.Theme.Shaper.LayoutString(text.Font{}, fixed.I(gtx.Px(size)), 1e6, txt)
+	width := 0
+	for _, line := range lines {
+		if w := line.Width.Ceil(); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// Grid lays out cells in as many equally wide columns as fit the width it
+// is given, between MinColumns and MaxColumns. Cells are numbered down the
+// columns, so a list reads top to bottom and then left to right.
+type Grid struct {
+	MinColumns int
+	MaxColumns int
+	// Gap is the space between columns.
+	Gap unit.Value
+}
+
+// Columns returns how many columns of at least cellWidth pixels fit into
+// width for n cells.
+func (g Grid) Columns(width, cellWidth, gap, n int) int {
+	columns := 1
+	if cellWidth+gap > 0 {
+		columns = (width + gap) / (cellWidth + gap)
+	}
+	if g.MaxColumns > 0 && columns > g.MaxColumns {
+		columns = g.MaxColumns
+	}
+	if columns > n {
+		columns = n
+	}
+	if columns < g.MinColumns {
+		columns = g.MinColumns
+	}
+	if columns < 1 {
+		columns = 1
+	}
+	return columns
+}
+
+// Layout lays out n cells that need at least cellWidth pixels each. The
+// cells of a row are lined up with each other.
+func (g Grid) Layout(gtx layout.Context, n, cellWidth int, cell func(gtx layout.Context, index int) layout.Dimensions) layout.Dimensions {
+	if n == 0 {
+		return layout.Dimensions{}
+	}
+
+	gap := gtx.Px(g.Gap)
+	columns := g.Columns(gtx.Constraints.Max.X, cellWidth, gap, n)
+	rows := (n + columns - 1) / columns
+	width := (gtx.Constraints.Max.X - gap*(columns-1)) / columns
+	if width < 0 {
+		width = 0
+	}
+
+	y := 0
+	for row := 0; row < rows; row++ {
+		height := 0
+		for column := 0; column < columns; column++ {
+			index := column*rows + row
+			if index >= n {
+				break
+			}
+
+			stack := op.Push(gtx.Ops)
+			op.TransformOp{}.Offset(f32.Point{
+				X: float32(column * (width + gap)),
+				Y: float32(y),
+			}).Add(gtx.Ops)
+			cgtx := gtx
+			cgtx.Constraints = layout.Constraints{
+				Min: image.Point{X: width},
+				Max: image.Point{X: width, Y: gtx.Constraints.Max.Y},
+			}
+			dims := cell(cgtx, index)
+			stack.Pop()
+
+			if dims.Size.Y > height {
+				height = dims.Size.Y
+			}
+		}
+		y += height
+	}
+
+	return layout.Dimensions{Size: image.Point{X: gtx.Constraints.Max.X, Y: y}}
+}
@@ -0,0 +1,38 @@
+package theme
+
+import "testing"
+
+func TestGridColumns(t *testing.T) {
+	words := Grid{MinColumns: 1, MaxColumns: 6}
+	tests := []struct {
+		name      string
+		grid      Grid
+		width     int
+		cellWidth int
+		gap       int
+		n         int
+		want      int
+	}{
+		{"zero width", words, 0, 100, 20, 33, 1},
+		{"narrower than a cell", words, 50, 100, 20, 33, 1},
+		{"one cell", words, 100, 100, 20, 33, 1},
+		{"no room for the gap", words, 219, 100, 20, 33, 1},
+		{"two cells and a gap", words, 220, 100, 20, 33, 2},
+		{"three cells", words, 400, 100, 20, 33, 3},
+		{"wide", words, 2000, 100, 20, 33, 6},
+		{"wide without a maximum", Grid{}, 2000, 100, 20, 33, 16},
+		{"fewer cells than columns", words, 2000, 100, 20, 4, 4},
+		{"no cells", words, 2000, 100, 20, 0, 1},
+		{"minimum on a narrow width", Grid{MinColumns: 2, MaxColumns: 6}, 50, 100, 20, 33, 2},
+		{"zero cell width", words, 500, 0, 0, 33, 1},
+		{"zero cell width with a gap", words, 500, 0, 20, 33, 6},
+		{"zero width and cell width", Grid{}, 0, 0, 0, 33, 1},
+	}
+
+	for _, test := range tests {
+		got := test.grid.Columns(test.width, test.cellWidth, test.gap, test.n)
+		if got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package theme
+
+import (
+	"image"
+
+	"gioui.org/gesture"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+const modalWidth = 420
+
+// Modal is a dialog drawn over a page, e.g. to confirm discarding a secret
+// or to show that an export is running. A modal with a secondary button is
+// also closed by Escape or a click outside of it, as if that button was
+// clicked. A modal without buttons stays open until it is hidden.
+type Modal struct {
+	// Body is the text below the title, it may change while the modal is
+	// shown.
+	Body string
+
+	theme        *Theme
+	titleLabel   material.LabelStyle
+	hasPrimary   bool
+	hasSecondary bool
+	visible      bool
+	confirmed    bool
+	dismissed    bool
+	outside      gesture.Click
+	primary      widget.Clickable
+	secondary    widget.Clickable
+	primaryBtn   Button
+	secondaryBtn Button
+}
+
+// Modal returns a hidden modal titled title. primary and secondary are the
+// labels of its buttons, a button is left out if its label is empty.
+func (t *Theme) Modal(title, primary, secondary string) *Modal {
+	m := &Modal{
+		theme:        t,
+		titleLabel:   t.H6(title),
+		hasPrimary:   primary != "",
+		hasSecondary: secondary != "",
+	}
+	m.primaryBtn = t.Button(primary, &m.primary)
+	m.secondaryBtn = t.SecondaryButton(secondary, &m.secondary)
+	return m
+}
+
+// Show opens the modal with body as its text.
+func (m *Modal) Show(body string) {
+	m.Body = body
+	m.visible = true
+	m.confirmed = false
+	m.dismissed = false
+}
+
+// Hide closes the modal without reporting it as confirmed or dismissed.
+func (m *Modal) Hide() {
+	m.visible = false
+}
+
+func (m *Modal) Visible() bool {
+	return m.visible
+}
+
+// Confirmed reports whether the primary button was clicked since it was
+// last called.
+func (m *Modal) Confirmed() bool {
+	confirmed := m.confirmed
+	m.confirmed = false
+	return confirmed
+}
+
+// Dismissed reports whether the modal was closed with the secondary button,
+// Escape or a click outside of it since it was last called.
+func (m *Modal) Dismissed() bool {
+	dismissed := m.dismissed
+	m.dismissed = false
+	return dismissed
+}
+
+func (m *Modal) confirm() {
+	m.visible = false
+	m.confirmed = true
+}
+
+func (m *Modal) dismiss() {
+	if !m.hasSecondary {
+		return
+	}
+	m.visible = false
+	m.dismissed = true
+}
+
+// HandleEvent closes the modal on Escape and confirms it on Enter. Every key
+// is taken while the modal is shown so that the page below doesn't act on
+// it.
+func (m *Modal) HandleEvent(e key.Event) bool {
+	if !m.visible {
+		return false
+	}
+	switch e.Name {
+	case key.NameEscape:
+		m.dismiss()
+	case key.NameReturn, key.NameEnter:
+		if m.hasPrimary {
+			m.confirm()
+		}
+	}
+	return true
+}
+
+func (m *Modal) update(gtx layout.Context) {
+	changed := false
+	for m.primary.Clicked() {
+		m.confirm()
+		changed = true
+	}
+	for m.secondary.Clicked() {
+		m.dismiss()
+		changed = true
+	}
+	for _, e := range m.outside.Events(gtx) {
+		if e.Type == gesture.TypeClick {
+			m.dismiss()
+			changed = true
+		}
+	}
+	if changed {
+		// the page picks the result up on the next frame
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+}
+
+// Layout draws the modal centered over the area it is given, dimming what
+// is below it. Nothing is drawn while the modal is hidden.
+func (m *Modal) Layout(gtx layout.Context) layout.Dimensions {
+	if !m.visible {
+		return layout.Dimensions{}
+	}
+	m.update(gtx)
+	if !m.visible {
+		return layout.Dimensions{}
+	}
+
+	size := gtx.Constraints.Max
+	overlay := m.theme.Color.Overlay
+	overlay.A = 200
+
+	stack := op.Push(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	m.outside.Add(gtx.Ops)
+	fill(gtx, size.X, size.Y, overlay)
+	stack.Pop()
+
+	gtx.Constraints.Min = size
+	layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		width := gtx.Px(unit.Dp(modalWidth))
+		if max := gtx.Constraints.Max.X - gtx.Px(unit.Dp(40)); width > max {
+			width = max
+		}
+		gtx.Constraints.Min.X = width
+		gtx.Constraints.Max.X = width
+
+		return layout.Stack{}.Layout(gtx,
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				// clicks on the dialog itself don't count as outside
+				pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+				pointer.InputOp{Tag: m}.Add(gtx.Ops)
+				return Fill(gtx, m.theme.Color.Surface)
+			}),
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(20)).Layout(gtx, m.layoutContent)
+			}),
+		)
+	})
+
+	return layout.Dimensions{Size: size}
+}
+
+func (m *Modal) layoutContent(gtx layout.Context) layout.Dimensions {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(m.titleLabel.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(10)}.Layout(gtx, m.theme.Body1(m.Body).Layout)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !m.hasPrimary && !m.hasSecondary {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Top: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceStart}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if !m.hasSecondary {
+							return layout.Dimensions{}
+						}
+						return m.secondaryBtn.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if !m.hasPrimary {
+							return layout.Dimensions{}
+						}
+						return layout.Inset{Left: unit.Dp(10)}.Layout(gtx, m.primaryBtn.Layout)
+					}),
+				)
+			})
+		}),
+	)
+}
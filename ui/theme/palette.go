@@ -0,0 +1,117 @@
+package theme
+
+import (
+	"image/color"
+)
+
+// Colors are the colors every widget is drawn with.
+type Colors struct {
+	Primary    color.RGBA
+	Secondary  color.RGBA
+	Text       color.RGBA
+	Hint       color.RGBA
+	Overlay    color.RGBA
+	InvText    color.RGBA
+	Success    color.RGBA
+	Danger     color.RGBA
+	Warning    color.RGBA
+	Background color.RGBA
+	Gray       color.RGBA
+	Black      color.RGBA
+	Surface    color.RGBA
+	// Divider separates the navigation from the page.
+	Divider color.RGBA
+	// Focus underlines the focused editor.
+	Focus color.RGBA
+}
+
+// Palette is a named set of colors that can be switched to at runtime.
+type Palette struct {
+	Name  string
+	Title string
+	Color Colors
+}
+
+var (
+	LightPalette = Palette{
+		Name:  "light",
+		Title: "Light",
+		Color: Colors{
+			Primary:    keyblue,
+			Secondary:  rgb(0x596d81),
+			Text:       darkblue,
+			Hint:       rgb(0xbbbbbb),
+			Overlay:    rgb(0x000000),
+			InvText:    rgb(0xffffff),
+			Success:    green,
+			Danger:     rgb(0xff0000),
+			Warning:    orange,
+			Background: argb(0x33444444),
+			Gray:       rgb(0x808080),
+			Black:      rgb(0x000000),
+			Surface:    rgb(0xffffff),
+			Divider:    rgb(0xcccccc),
+			Focus:      keyblue,
+		},
+	}
+
+	DarkPalette = Palette{
+		Name:  "dark",
+		Title: "Dark",
+		Color: Colors{
+			Primary:    keyblue,
+			Secondary:  rgb(0x3d5873),
+			Text:       rgb(0xe6e8ef),
+			Hint:       rgb(0x8a8f9c),
+			Overlay:    rgb(0x000000),
+			InvText:    rgb(0xffffff),
+			Success:    green,
+			Danger:     rgb(0xff4d4d),
+			Warning:    orange,
+			Background: rgb(0x1c1f26),
+			Gray:       rgb(0x808080),
+			Black:      rgb(0x000000),
+			Surface:    rgb(0x262a33),
+			Divider:    rgb(0x3a3f4b),
+			Focus:      lightblue,
+		},
+	}
+
+	// HighContrastPalette draws light text on black with saturated accents,
+	// accent colored surfaces carry black text.
+	HighContrastPalette = Palette{
+		Name:  "high-contrast",
+		Title: "High contrast",
+		Color: Colors{
+			Primary:    rgb(0xffd600),
+			Secondary:  rgb(0x00e5ff),
+			Text:       rgb(0xffffff),
+			Hint:       rgb(0xcccccc),
+			Overlay:    rgb(0x000000),
+			InvText:    rgb(0x000000),
+			Success:    rgb(0x00e676),
+			Danger:     rgb(0xff5252),
+			Warning:    rgb(0xffab00),
+			Background: rgb(0x000000),
+			Gray:       rgb(0xcccccc),
+			Black:      rgb(0x000000),
+			Surface:    rgb(0x000000),
+			Divider:    rgb(0xffffff),
+			Focus:      rgb(0xffd600),
+		},
+	}
+
+	// Palettes lists the palettes in the order they are offered in.
+	Palettes = []Palette{LightPalette, DarkPalette, HighContrastPalette}
+)
+
+// PaletteByName returns the palette called name, or the light palette if
+// there is none.
+func PaletteByName(name string) Palette {
+	for _, palette := range Palettes {
+		if palette.Name == name {
+			return palette
+		}
+	}
+	return LightPalette
+}
@@ -0,0 +1,21 @@
+package theme
+
+import (
+	"gioui.org/op/paint"
+	"gioui.org/widget"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// QRCode encodes content as a QR code image of size by size pixels.
+func QRCode(content string, size int) (widget.Image, error) {
+	qr, err := qrcode.New(content, qrcode.Low)
+	if err != nil {
+		return widget.Image{}, err
+	}
+
+	return widget.Image{
+		Src:   paint.NewImageOp(qr.Image(size)),
+		Scale: 1,
+	}, nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package theme
+
+import (
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+)
+
+// mask is shown in place of a hidden value. It has a fixed length so that it
+// doesn't give away the length of the value.
+const mask = "••••••••"
+
+// Reveal holds whether a masked value is shown. A Reveal created with a
+// parent is also shown while its parent is, which lets a page reveal all of
+// its values at once.
+type Reveal struct {
+	parent   *Reveal
+	toggle   widget.Clickable
+	hold     widget.Clickable
+	revealed bool
+}
+
+// MaskedLabel draws a value that is hidden until its Reveal is toggled.
+type MaskedLabel struct {
+	Label      material.LabelStyle
+	value      func() string
+	reveal     *Reveal
+	toggleIcon IconButton
+	hideIcon   *Icon
+}
+
+// RevealControls draws the buttons that reveal every value under a Reveal,
+// either until toggled again or only while the hold button is pressed.
+type RevealControls struct {
+	HoldToReveal bool
+	reveal       *Reveal
+	toggleButton Button
+	holdButton   Button
+}
+
+func NewReveal(parent *Reveal) *Reveal {
+	return &Reveal{
+		parent: parent,
+	}
+}
+
+// Revealed reports whether the value should be shown.
+func (r *Reveal) Revealed() bool {
+	for r.toggle.Clicked() {
+		r.revealed = !r.revealed
+	}
+
+	if r.revealed || r.isHeld() {
+		return true
+	}
+	return r.parent != nil && r.parent.Revealed()
+}
+
+// Hide masks the value again.
+func (r *Reveal) Hide() {
+	r.revealed = false
+}
+
+func (r *Reveal) isHeld() bool {
+	history := r.hold.History()
+	if len(history) == 0 {
+		return false
+	}
+	press := history[len(history)-1]
+	return press.End.IsZero() && !press.Cancelled
+}
+
+// MaskedLabel returns a label for the value returned by value. value is only
+// called while the label is revealed, so secrets are not turned into strings
+// while they are hidden.
+func (t *Theme) MaskedLabel(label material.LabelStyle, value func() string, reveal *Reveal) MaskedLabel {
+	toggleIcon := t.IconButton(t.revealIcon, &reveal.toggle)
+	toggleIcon.Background = t.Color.Background
+	toggleIcon.Color = t.Color.Text
+	toggleIcon.Size = unit.Dp(16)
+	toggleIcon.Padding = unit.Dp(2)
+
+	return MaskedLabel{
+		Label:      label,
+		value:      value,
+		reveal:     reveal,
+		toggleIcon: toggleIcon,
+		hideIcon:   t.hideIcon,
+	}
+}
+
+func (m MaskedLabel) Layout(gtx layout.Context) layout.Dimensions {
+	if m.reveal.Revealed() {
+		m.Label.Text = m.value()
+		m.toggleIcon.Icon = m.hideIcon
+	} else {
+		m.Label.Text = mask
+	}
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			// long values wrap before the toggle instead of pushing it
+			// out of the space given
+			gtx.Constraints.Max.X -= gtx.Px(m.toggleIcon.Size) + gtx.Px(unit.Dp(5))
+			if gtx.Constraints.Max.X < 0 {
+				gtx.Constraints.Max.X = 0
+			}
+			if gtx.Constraints.Min.X > gtx.Constraints.Max.X {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			}
+			return m.Label.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, m.toggleIcon.Layout)
+		}),
+	)
+}
+
+// RevealControls returns the controls for revealing every value under reveal.
+func (t *Theme) RevealControls(reveal *Reveal) RevealControls {
+	return RevealControls{
+		HoldToReveal: true,
+		reveal:       reveal,
+		toggleButton: t.SecondaryButton(i18n.T("Show All"), &reveal.toggle),
+		holdButton:   t.SecondaryButton(i18n.T("Hold to Reveal"), &reveal.hold),
+	}
+}
+
+func (c RevealControls) Layout(gtx layout.Context) layout.Dimensions {
+	// process clicks before picking the label
+	c.reveal.Revealed()
+	if c.reveal.revealed {
+		c.toggleButton.btn.Text = i18n.T("Hide All")
+	}
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		layout.Rigid(c.toggleButton.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !c.HoldToReveal {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, c.holdButton.Layout)
+		}),
+	)
+}
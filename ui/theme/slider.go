@@ -8,6 +8,7 @@ import (
 	"gioui.org/f32"
 	"gioui.org/layout"
 	"gioui.org/op"
+	"gioui.org/op/clip"
 )
 
 const defaultDuration = 300 * time.Millisecond
@@ -33,6 +34,19 @@ func (s *Slider) PushLeft() { s.push = 1 }
 // PushRight pushes the existing widget to the right.
 func (s *Slider) PushRight() { s.push = -1 }
 
+// Slide pushes the widget shown by s out of the way of the next one, to the
+// left when going forwards. Nothing moves while motion is off.
+func (t *Theme) Slide(s *Slider, forwards bool) {
+	if !t.Motion {
+		return
+	}
+	if forwards {
+		s.PushLeft()
+	} else {
+		s.PushRight()
+	}
+}
+
 // Layout lays out widget that can be pushed.
 func (s *Slider) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
 	if s.push != 0 {
@@ -91,6 +105,9 @@ func (s *Slider) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
 
 	defer op.Push(gtx.Ops).Pop()
 
+	// keep both widgets inside the area being slid
+	clip.Rect{Rect: f32.Rectangle{Max: layout.FPt(dims.Size)}}.Add(gtx.Ops)
+
 	offset := smooth(s.offset)
 
 	if s.offset > 0 {
@@ -0,0 +1,357 @@
+package theme
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// TableColumn describes a column of a Table.
+type TableColumn struct {
+	Title string
+	// Width is the share of the table's width the column takes.
+	Width float32
+	// Value returns the text of the cell in row, the column is sorted,
+	// filtered and drawn by it. Columns holding secrets leave it nil and
+	// draw their cells with Cell instead, they can't be sorted or
+	// filtered by.
+	Value func(row int) string
+	// Cell draws the cell in row, a caption of Value is drawn if it is nil.
+	Cell func(gtx layout.Context, row int) layout.Dimensions
+	// Copy adds a copy button to every cell of the column, clicking it
+	// calls the table's OnCopy.
+	Copy bool
+	// Hidden leaves the column out of the table, it is changed with
+	// SetHidden so that the filter is applied again.
+	Hidden bool
+}
+
+// Table draws rows in columns below a filter editor. Rows are numbered in
+// the order they were given and can be sorted by clicking a column title,
+// clicking "#" puts them back in order. Only the rows scrolled into view are
+// laid out, so hundreds of thousands of rows are fine.
+type Table struct {
+	Columns []TableColumn
+	// OnCopy is called with the row and column of a copy button that was
+	// clicked.
+	OnCopy func(row, column int)
+
+	theme    *Theme
+	rowCount int
+	// order holds the rows that match the filter, in the order shown.
+	order      []int
+	sortColumn int
+	descending bool
+	filter     string
+	selected   int
+	// numberWidth is the width of the largest row number.
+	numberWidth int
+
+	filterEditorWidget   *widget.Editor
+	filterEditorMaterial Editor
+	numberHeader         widget.Clickable
+	headers              []widget.Clickable
+	list                 *layout.List
+	copyIcon             *Icon
+
+	// rows holds the widgets of the rows drawn so far, they are only made
+	// once a row is scrolled into view.
+	rows map[int]*tableRow
+}
+
+type tableRow struct {
+	click widget.Clickable
+	copy  []widget.Clickable
+}
+
+// Table returns an empty table with columns.
+func (t *Theme) Table(columns []TableColumn) *Table {
+	table := &Table{
+		Columns:    columns,
+		theme:      t,
+		sortColumn: -1,
+		selected:   -1,
+		headers:    make([]widget.Clickable, len(columns)),
+		list:       &layout.List{Axis: layout.Vertical},
+		copyIcon:   MustIcon(NewIcon(icons.ContentContentCopy)),
+		rows:       make(map[int]*tableRow),
+	}
+	table.filterEditorWidget = &widget.Editor{SingleLine: true}
+	table.filterEditorMaterial = t.Editor(i18n.T("Filter"), table.filterEditorWidget)
+	return table
+}
+
+// SetRowCount replaces the rows of the table with n new ones, the filter
+// and sort order are kept.
+func (table *Table) SetRowCount(n int) {
+	table.rowCount = n
+	table.selected = -1
+	table.rows = make(map[int]*tableRow)
+	table.list.Position = layout.Position{}
+	table.refresh()
+}
+
+// SetHidden hides or shows column. Hiding the column the rows are sorted by
+// puts them back in order.
+func (table *Table) SetHidden(column int, hidden bool) {
+	if table.Columns[column].Hidden == hidden {
+		return
+	}
+	table.Columns[column].Hidden = hidden
+	if hidden && table.sortColumn == column {
+		table.sortColumn = -1
+		table.descending = false
+	}
+	table.refresh()
+}
+
+// Selected returns the row that was clicked last, if there is one.
+func (table *Table) Selected() (int, bool) {
+	return table.selected, table.selected >= 0
+}
+
+// refresh works out the rows to show from the filter and sort order.
+func (table *Table) refresh() {
+	filter := strings.ToLower(strings.TrimSpace(table.filter))
+
+	table.order = table.order[:0]
+	for row := 0; row < table.rowCount; row++ {
+		if filter == "" || table.matches(row, filter) {
+			table.order = append(table.order, row)
+		}
+	}
+
+	if table.sortColumn < 0 {
+		return
+	}
+	// work out every value once rather than on each comparison
+	value := table.Columns[table.sortColumn].Value
+	rows := make([]tableSortRow, len(table.order))
+	for i, row := range table.order {
+		rows[i] = tableSortRow{row: row, value: value(row)}
+	}
+	// rows with the same value stay in order
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.value == b.value {
+			return a.row < b.row
+		}
+		if table.descending {
+			return b.value < a.value
+		}
+		return a.value < b.value
+	})
+	for i := range rows {
+		table.order[i] = rows[i].row
+	}
+}
+
+// tableSortRow is a row with the value it is sorted by.
+type tableSortRow struct {
+	row   int
+	value string
+}
+
+// matches reports whether any column shown has filter in row.
+func (table *Table) matches(row int, filter string) bool {
+	for _, column := range table.Columns {
+		if column.Hidden || column.Value == nil {
+			continue
+		}
+		if strings.Contains(strings.ToLower(column.Value(row)), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func (table *Table) handleEvents() {
+	changed := false
+
+	if text := table.filterEditorWidget.Text(); text != table.filter {
+		table.filter = text
+		changed = true
+	}
+
+	for table.numberHeader.Clicked() {
+		table.sortColumn = -1
+		changed = true
+	}
+
+	for i := range table.headers {
+		for table.headers[i].Clicked() {
+			if table.Columns[i].Value == nil {
+				continue
+			}
+			if table.sortColumn == i {
+				table.descending = !table.descending
+			} else {
+				table.sortColumn = i
+				table.descending = false
+			}
+			changed = true
+		}
+	}
+
+	if changed {
+		table.refresh()
+	}
+}
+
+// Layout draws the filter editor, the column titles and the rows in view
+// below them.
+func (table *Table) Layout(gtx layout.Context) layout.Dimensions {
+	table.handleEvents()
+	table.numberWidth = table.theme.TextWidth(gtx, table.theme.TextSize, strconv.Itoa(table.rowCount))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, table.filterEditorMaterial.Layout)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Bottom: unit.Dp(10)}.Layout(gtx, table.layoutHeader)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if len(table.order) == 0 {
+				label := table.theme.Caption(i18n.T("No rows match the filter"))
+				label.Color = table.theme.Color.Hint
+				return label.Layout(gtx)
+			}
+			return table.list.Layout(gtx, len(table.order), func(gtx layout.Context, i int) layout.Dimensions {
+				return table.layoutRow(gtx, table.order[i])
+			})
+		}),
+	)
+}
+
+// numberColumn returns the column holding the row numbers, it is as wide as
+// the largest number.
+func (table *Table) numberColumn(w layout.Widget) layout.FlexChild {
+	return layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = table.numberWidth
+		return layout.Inset{Right: unit.Dp(10)}.Layout(gtx, w)
+	})
+}
+
+// cell lays out a cell of column, leaving a gap before the next column so
+// that long values wrap instead of running into it.
+func cell(column TableColumn, w layout.Widget) layout.FlexChild {
+	return layout.Flexed(column.Width, func(gtx layout.Context) layout.Dimensions {
+		return layout.Inset{Right: unit.Dp(10)}.Layout(gtx, w)
+	})
+}
+
+func (table *Table) layoutHeader(gtx layout.Context) layout.Dimensions {
+	title := func(button *widget.Clickable, text string) layout.Widget {
+		return func(gtx layout.Context) layout.Dimensions {
+			label := table.theme.Label(unit.Dp(16), text)
+			label.Color = table.theme.Color.Hint
+			return layout.Stack{}.Layout(gtx,
+				layout.Stacked(label.Layout),
+				layout.Expanded(button.Layout),
+			)
+		}
+	}
+
+	children := []layout.FlexChild{table.numberColumn(title(&table.numberHeader, "#"))}
+	for i, column := range table.Columns {
+		if column.Hidden {
+			continue
+		}
+		text := column.Title
+		if i == table.sortColumn {
+			if table.descending {
+				text += " ▼"
+			} else {
+				text += " ▲"
+			}
+		}
+		children = append(children, cell(column, title(&table.headers[i], text)))
+	}
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+}
+
+func (table *Table) layoutRow(gtx layout.Context, row int) layout.Dimensions {
+	widgets, ok := table.rows[row]
+	if !ok {
+		widgets = &tableRow{copy: make([]widget.Clickable, len(table.Columns))}
+		table.rows[row] = widgets
+	}
+
+	for widgets.click.Clicked() {
+		table.selected = row
+	}
+	for i := range widgets.copy {
+		for widgets.copy[i].Clicked() {
+			if table.OnCopy != nil {
+				table.OnCopy(row, i)
+			}
+		}
+	}
+
+	children := []layout.FlexChild{table.numberColumn(table.theme.Caption(strconv.Itoa(row + 1)).Layout)}
+	for i, column := range table.Columns {
+		if column.Hidden {
+			continue
+		}
+		i, column := i, column
+		children = append(children, cell(column, func(gtx layout.Context) layout.Dimensions {
+			return table.layoutCell(gtx, row, column, &widgets.copy[i])
+		}))
+	}
+
+	return layout.Inset{Bottom: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Stack{}.Layout(gtx,
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				if row == table.selected {
+					Fill(gtx, mulAlpha(table.theme.Color.Primary, 40))
+				}
+				// clicks on the row select it, the buttons in its cells
+				// are drawn over this and get their own clicks
+				return widgets.click.Layout(gtx)
+			}),
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				return layout.Inset{Top: unit.Dp(5), Bottom: unit.Dp(5)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, children...)
+				})
+			}),
+		)
+	})
+}
+
+func (table *Table) layoutCell(gtx layout.Context, row int, column TableColumn, copyButton *widget.Clickable) layout.Dimensions {
+	content := func(gtx layout.Context) layout.Dimensions {
+		if column.Cell != nil {
+			return column.Cell(gtx, row)
+		}
+		return table.theme.Caption(column.Value(row)).Layout(gtx)
+	}
+	if !column.Copy {
+		return content(gtx)
+	}
+
+	copyIcon := table.theme.IconButton(table.copyIcon, copyButton)
+	copyIcon.Background = table.theme.Color.Background
+	copyIcon.Color = table.theme.Color.Text
+	copyIcon.Size = unit.Dp(18)
+	copyIcon.Padding = unit.Dp(2)
+
+	// the value wraps in the space left by the copy button
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = 0
+			return content(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(5)}.Layout(gtx, copyIcon.Layout)
+		}),
+	)
+}
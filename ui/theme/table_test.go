@@ -0,0 +1,165 @@
+package theme
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"gioui.org/layout"
+)
+
+// testTable returns a table of rows with the row number in the first column
+// and the number backwards in the second, without a theme to draw it with.
+func testTable(rows int) *Table {
+	table := &Table{
+		Columns: []TableColumn{
+			{Title: "Number", Value: func(row int) string { return strconv.Itoa(row) }},
+			{Title: "Backwards", Value: func(row int) string { return reverse(strconv.Itoa(row)) }},
+			{Title: "Secret"},
+		},
+		sortColumn: -1,
+		selected:   -1,
+		list:       &layout.List{Axis: layout.Vertical},
+	}
+	table.SetRowCount(rows)
+	return table
+}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+func TestTableRefresh(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     string
+		sortColumn int
+		descending bool
+		hidden     []int
+		want       []int
+	}{
+		{name: "all rows", sortColumn: -1, want: []int{0, 1, 2, 10, 11, 12, 21}},
+		{name: "filter", filter: "1", sortColumn: -1, want: []int{1, 10, 11, 12, 21}},
+		{name: "filter ignores surrounding space", filter: " 2 ", sortColumn: -1, want: []int{2, 12, 21}},
+		{name: "no match", filter: "9", sortColumn: -1, want: []int{}},
+		{name: "sort", sortColumn: 1, want: []int{0, 10, 1, 11, 21, 2, 12}},
+		{name: "sort descending", sortColumn: 1, descending: true, want: []int{12, 2, 21, 11, 1, 10, 0}},
+		{name: "filter and sort", filter: "2", sortColumn: 1, want: []int{21, 2, 12}},
+		{name: "hidden columns aren't filtered", filter: "12", sortColumn: -1, hidden: []int{0}, want: []int{21}},
+	}
+
+	values := []int{0, 1, 2, 10, 11, 12, 21}
+	for _, test := range tests {
+		table := testTable(len(values))
+		number := func(row int) string { return strconv.Itoa(values[row]) }
+		table.Columns[0].Value = number
+		table.Columns[1].Value = func(row int) string { return reverse(number(row)) }
+		for _, column := range test.hidden {
+			table.SetHidden(column, true)
+		}
+		table.filter = test.filter
+		table.sortColumn = test.sortColumn
+		table.descending = test.descending
+		table.refresh()
+
+		got := make([]int, len(table.order))
+		for i, row := range table.order {
+			got[i] = values[row]
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTableHideSortColumn(t *testing.T) {
+	table := testTable(20)
+	table.sortColumn = 1
+	table.descending = true
+	table.refresh()
+
+	table.SetHidden(0, true)
+	if table.sortColumn != 1 {
+		t.Errorf("hiding another column: sort column got %d, want 1", table.sortColumn)
+	}
+
+	table.SetHidden(1, true)
+	if table.sortColumn != -1 || table.descending {
+		t.Errorf("hiding the sort column: got column %d descending %v, want -1 false", table.sortColumn, table.descending)
+	}
+	for i, row := range table.order {
+		if row != i {
+			t.Fatalf("row %d: got %d, want the rows in order", i, row)
+		}
+	}
+}
+
+func TestTableSortKeepsOrderOfEqualValues(t *testing.T) {
+	table := testTable(6)
+	table.Columns[0].Value = func(row int) string { return strconv.Itoa(row % 2) }
+	for _, descending := range []bool{false, true} {
+		table.sortColumn = 0
+		table.descending = descending
+		table.refresh()
+
+		want := []int{0, 2, 4, 1, 3, 5}
+		if descending {
+			want = []int{1, 3, 5, 0, 2, 4}
+		}
+		if !reflect.DeepEqual(table.order, want) {
+			t.Errorf("descending %v: got %v, want %v", descending, table.order, want)
+		}
+	}
+}
+
+func TestTableManyRows(t *testing.T) {
+	const rows = 100000
+	table := testTable(rows)
+	if len(table.order) != rows {
+		t.Fatalf("rows: got %d, want %d", len(table.order), rows)
+	}
+
+	table.filter = "99999"
+	table.refresh()
+	if want := []int{99999}; !reflect.DeepEqual(table.order, want) {
+		t.Errorf("filter: got %v, want %v", table.order, want)
+	}
+
+	table.filter = ""
+	table.sortColumn = 0
+	table.descending = true
+	table.refresh()
+	if table.order[0] != 99999 || table.order[rows-1] != 0 {
+		t.Errorf("sort descending: first %d, last %d, want 99999 and 0", table.order[0], table.order[rows-1])
+	}
+}
+
+func BenchmarkTableRefresh(b *testing.B) {
+	const rows = 100000
+	benchmarks := []struct {
+		name       string
+		filter     string
+		sortColumn int
+	}{
+		{"all", "", -1},
+		{"filter", "12", -1},
+		{"sort", "", 1},
+		{"filter and sort", "12", 1},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			table := testTable(rows)
+			table.filter = benchmark.filter
+			table.sortColumn = benchmark.sortColumn
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				table.refresh()
+			}
+		})
+	}
+}
@@ -47,6 +47,34 @@ func (t *Tabs) SelectedID() string {
 	return t.tabs[t.selected].ID
 }
 
+// Select switches to the tab at index, e.g. from a keyboard shortcut. Indexes
+// without a tab are ignored.
+func (t *Tabs) Select(index int) {
+	if index < 0 || index >= len(t.tabs) || index == t.selected {
+		return
+	}
+	t.slideTo(index)
+}
+
+// slideTo switches to the tab at index, sliding its content in from the
+// side it is on.
+func (t *Tabs) slideTo(index int) {
+	t.theme.Slide(&t.slider, index > t.selected)
+	t.selected = index
+	t.changed = true
+}
+
+// SelectID switches to the tab with the given id without reporting it as a
+// change.
+func (t *Tabs) SelectID(id string) {
+	for i := range t.tabs {
+		if t.tabs[i].ID == id {
+			t.selected = i
+			return
+		}
+	}
+}
+
 func (t *Tabs) Changed() bool {
 	return t.changed
 }
@@ -58,11 +86,8 @@ func (t *Tabs) Layout(gtx layout.Context) layout.Dimensions {
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 			return t.list.Layout(gtx, len(t.tabs), func(gtx layout.Context, tabID int) layout.Dimensions {
 				current := &t.tabs[tabID]
-				if current.btn.Clicked() {
-					if tabID != t.selected {
-						t.changed = true
-					}
-					t.selected = tabID
+				if current.btn.Clicked() && tabID != t.selected {
+					t.slideTo(tabID)
 				}
 
 				gtx.Constraints.Max.X = 150
@@ -85,11 +110,11 @@ func (t *Tabs) Layout(gtx layout.Context) layout.Dimensions {
 			})
 		}),
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			return line(gtx, 2, gtx.Constraints.Max.Y, rgb(0xcccccc))(gtx)
+			return line(gtx, 2, gtx.Constraints.Max.Y, t.theme.Color.Divider)(gtx)
 		}),
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 			gtx.Constraints.Min = gtx.Constraints.Max
-			return t.tabs[t.selected].Content(gtx)
+			return t.slider.Layout(gtx, t.tabs[t.selected].Content)
 		}),
 	)
 }
@@ -25,56 +25,54 @@ var (
 
 	// decred complemetary colors
 
-	//lightblue = rgb(0x70cbff)
-	//orange    = rgb(0xed6d47)
-	green = rgb(0x41bf53)
+	lightblue = rgb(0x70cbff)
+	orange    = rgb(0xed6d47)
+	green     = rgb(0x41bf53)
 )
 
 type Theme struct {
 	*material.Theme
-	Shaper text.Shaper
-	Color  struct {
-		Primary    color.RGBA
-		Secondary  color.RGBA
-		Text       color.RGBA
-		Hint       color.RGBA
-		Overlay    color.RGBA
-		InvText    color.RGBA
-		Success    color.RGBA
-		Danger     color.RGBA
-		Background color.RGBA
-		Gray       color.RGBA
-		Black      color.RGBA
-		Surface    color.RGBA
-	}
-	TextSize           unit.Value
+	Shaper   text.Shaper
+	Palette  string
+	Color    Colors
+	TextSize unit.Value
+	// Motion animates moving between pages and views, it is turned off for
+	// users who prefer reduced motion.
+	Motion             bool
 	radioCheckedIcon   *Icon
 	radioUncheckedIcon *Icon
+	revealIcon         *Icon
+	hideIcon           *Icon
 }
 
-func New(col *text.Collection) *Theme {
+func New(col *text.Collection, palette Palette) *Theme {
 	t := &Theme{
 		Theme: material.NewTheme(col),
 	}
-	t.Color.Primary = keyblue
-	t.Color.Text = darkblue
-	t.Color.Hint = rgb(0xbbbbbb)
-	t.Color.InvText = rgb(0xffffff)
-	t.Color.Overlay = rgb(0x000000)
-	t.Color.Background = argb(0x33444444)
-	t.Color.Success = green
-	t.Color.Danger = rgb(0xff0000)
-	t.Color.Gray = rgb(0x808080)
-	t.Color.Black = rgb(0x000000)
-	t.Color.Surface = rgb(0xffffff)
+	t.SetPalette(palette)
 	t.TextSize = unit.Sp(16)
+	t.Motion = true
 
 	t.radioCheckedIcon = MustIcon(NewIcon(icons.ToggleRadioButtonChecked))
 	t.radioUncheckedIcon = MustIcon(NewIcon(icons.ToggleRadioButtonUnchecked))
+	t.revealIcon = MustIcon(NewIcon(icons.ActionVisibility))
+	t.hideIcon = MustIcon(NewIcon(icons.ActionVisibilityOff))
 
 	return t
 }
 
+// SetPalette switches to palette. Widgets pick up the new colors when they
+// are created, so pages have to be built again after switching.
+func (t *Theme) SetPalette(palette Palette) {
+	t.Palette = palette.Name
+	t.Color = palette.Color
+
+	t.Theme.Color.Primary = palette.Color.Primary
+	t.Theme.Color.Text = palette.Color.Text
+	t.Theme.Color.Hint = palette.Color.Hint
+	t.Theme.Color.InvText = palette.Color.InvText
+}
+
 func (t *Theme) alert(gtx layout.Context, txt string, bgColor color.RGBA) layout.Dimensions {
 	bgColor.A = 200
 
@@ -94,7 +92,7 @@ func (t *Theme) alert(gtx layout.Context, txt string, bgColor color.RGBA) layout
 			gtx.Constraints.Min.X = gtx.Constraints.Max.Y
 			return layout.UniformInset(unit.Dp(8)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 				lbl := t.Body2(txt)
-				lbl.Color = t.Color.Surface
+				lbl.Color = t.Color.InvText
 				return lbl.Layout(gtx)
 			})
 		}),
@@ -105,6 +103,10 @@ func (t *Theme) ErrorAlert(gtx layout.Context, txt string) layout.Dimensions {
 	return t.alert(gtx, txt, t.Color.Danger)
 }
 
+func (t *Theme) WarningAlert(gtx layout.Context, txt string) layout.Dimensions {
+	return t.alert(gtx, txt, t.Color.Warning)
+}
+
 func (t *Theme) SuccessAlert(gtx layout.Context, txt string) layout.Dimensions {
 	return t.alert(gtx, txt, t.Color.Success)
 }
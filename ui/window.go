@@ -2,14 +2,22 @@ package ui
 
 import (
 	"image"
+	"sync"
+	"time"
 
 	"gioui.org/app"
+	"gioui.org/io/key"
 	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/text"
 	"gioui.org/unit"
 
+	"github.com/atotto/clipboard"
+	"github.com/raedahgroup/dcrseedgen/helper"
+	"github.com/raedahgroup/dcrseedgen/seedgen"
+	"github.com/raedahgroup/dcrseedgen/ui/i18n"
+	"github.com/raedahgroup/dcrseedgen/ui/notify"
 	"github.com/raedahgroup/dcrseedgen/ui/pages"
 	"github.com/raedahgroup/dcrseedgen/ui/theme"
 )
@@ -18,57 +26,204 @@ const (
 	appName      = "Dcrseedgen"
 	windowHeight = 600
 	windowWidth  = 850
+
+	networkCheckInterval = 5 * time.Second
 )
 
-type Page interface {
-	BeforeRender()
-	Render(layout.Context) layout.Dimensions
+// secretPages generate long-term secrets, a warning is shown above them while
+// the machine is online.
+var secretPages = map[string]bool{
+	pages.SeedPageID:     true,
+	pages.MnemonicPageID: true,
+	pages.AddressPageID:  true,
 }
 
 type Window struct {
-	window          *app.Window
-	theme           *theme.Theme
-	pages           map[string]Page
-	currentPage     string
-	isRenderingPage bool
-	navTabs         *theme.Tabs
+	window      *app.Window
+	theme       *theme.Theme
+	decredIcons map[string]image.Image
+	clipboard   *helper.SecretClipboard
+	config      *helper.Config
+	notifier    *notify.Notifier
+	router      *Router
+	navTabs     *theme.Tabs
+
+	networkMu     sync.Mutex
+	networkStatus *seedgen.ConnectivityStatus
+	networkErr    error
 }
 
-func NewWindow(decredIcons map[string]image.Image, col *text.Collection) *Window {
+// NewWindow creates the main window with the settings in cfg. Secrets copied
+// from any page are taken off the clipboard again after clipboardTimeout,
+// which may differ from cfg if it was overridden on the command line.
+func NewWindow(decredIcons map[string]image.Image, col *text.Collection, cfg *helper.Config, clipboardTimeout time.Duration) *Window {
 	win := new(Window)
-	win.currentPage = pages.SeedPageID
-	win.isRenderingPage = false
+	win.config = cfg
+	win.clipboard = helper.NewSecretClipboard(clipboardTimeout)
 	win.window = app.NewWindow(
 		app.Size(unit.Dp(windowWidth), unit.Dp(windowHeight)),
 		app.Title(appName),
 	)
-	win.theme = theme.New(col)
-	win.registerPages(decredIcons)
+	win.notifier = notify.New(win.window.Invalidate)
+	win.theme = theme.New(col, theme.PaletteByName(cfg.Theme))
+	win.theme.Motion = !cfg.ReduceMotion
+	win.setLanguage(cfg.Language)
+	win.decredIcons = decredIcons
+	win.router = NewRouter(win.theme)
+	win.registerPages()
+
+	go win.watchNetwork()
 
 	return win
 }
 
-func (win *Window) registerPages(decredIcons map[string]image.Image) {
-	win.pages = map[string]Page{
-		pages.SeedPageID:    pages.NewSeedPage(win.theme),
-		pages.AddressPageID: pages.NewAddressPage(win.theme),
+// watchNetwork keeps checking whether the machine is online and redraws the
+// window when that changes.
+func (win *Window) watchNetwork() {
+	var previous string
+	for {
+		status, err := seedgen.CheckConnectivity()
+
+		win.networkMu.Lock()
+		win.networkStatus, win.networkErr = status, err
+		win.networkMu.Unlock()
+
+		current := ""
+		if err != nil {
+			current = err.Error()
+		} else {
+			current = status.String()
+		}
+		if current != previous {
+			previous = current
+			win.window.Invalidate()
+		}
+
+		time.Sleep(networkCheckInterval)
 	}
+}
+
+// registerPages builds every page with the current theme and language, it is
+// called again after switching either since widgets keep the colors and text
+// they were built with.
+func (win *Window) registerPages() {
+	addressPage := pages.NewAddressPage(win.theme, win.notifier, win.clipboard, win.config)
+	seedPage := pages.NewSeedPage(win.theme, win.clipboard, win.config, win.router)
+
+	win.router.SetPages(map[string]Page{
+		pages.SeedPageID:             seedPage,
+		pages.SeedVerificationPageID: pages.NewSeedVerificationPage(win.theme, win.notifier, seedPage, win.router),
+		pages.MnemonicPageID:         pages.NewMnemonicPage(win.theme, win.config),
+		pages.AddressPageID:          addressPage,
+		pages.SignMessagePageID:      pages.NewSignMessagePage(win.theme, win.notifier, addressPage),
+		pages.InspectorPageID:        pages.NewInspectorPage(win.theme, win.notifier),
+		pages.SignTransactionPageID:  pages.NewSignTransactionPage(win.theme, win.notifier),
+		pages.SweepPageID:            pages.NewSweepPage(win.theme, win.notifier),
+		pages.DecryptPageID:          pages.NewDecryptPage(win.theme),
+		pages.ExportsPageID:          pages.NewExportsPage(win.theme, win.notifier),
+		pages.SettingsPageID:         pages.NewSettingsPage(win.theme, win.notifier, win.config, win.applyConfig),
+	})
 
 	win.navTabs = win.theme.NewTabs()
 	win.navTabs.AddItems([]theme.Tab{
 		{
 			ID:      pages.SeedPageID,
-			Title:   "Generate Seed",
-			Content: win.pages[pages.SeedPageID].Render,
+			Title:   i18n.T("Generate Seed"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.MnemonicPageID,
+			Title:   i18n.T("BIP39 Mnemonic"),
+			Content: win.renderPage,
 		},
 		{
 			ID:      pages.AddressPageID,
-			Title:   "Generate Address",
-			Content: win.pages[pages.AddressPageID].Render,
+			Title:   i18n.T("Generate Address"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.SignMessagePageID,
+			Title:   i18n.T("Sign Message"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.SignTransactionPageID,
+			Title:   i18n.T("Sign Transaction"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.SweepPageID,
+			Title:   i18n.T("Sweep"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.InspectorPageID,
+			Title:   i18n.T("Inspect"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.DecryptPageID,
+			Title:   i18n.T("Decrypt Export"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.ExportsPageID,
+			Title:   i18n.T("Exports"),
+			Content: win.renderPage,
+		},
+		{
+			ID:      pages.SettingsPageID,
+			Title:   i18n.T("Settings"),
+			Content: win.renderPage,
 		},
 	})
 }
 
+// applyConfig puts settings that were just saved into effect. Only settings
+// that changed are applied so that saving doesn't undo command line flags.
+func (win *Window) applyConfig(previous helper.Config) {
+	if win.config.ExportDirectory != previous.ExportDirectory {
+		helper.SetExportDirectory(win.config.ExportDirectory)
+	}
+	if win.config.ClipboardTimeout != previous.ClipboardTimeout {
+		win.clipboard.SetTimeout(time.Duration(win.config.ClipboardTimeout))
+	}
+	win.theme.Motion = !win.config.ReduceMotion
+
+	rebuild := false
+	if win.config.Theme != previous.Theme {
+		win.theme.SetPalette(theme.PaletteByName(win.config.Theme))
+		rebuild = true
+	}
+	if win.config.Language != previous.Language {
+		win.setLanguage(win.config.Language)
+		rebuild = true
+	}
+	if rebuild {
+		win.rebuildPages()
+	}
+}
+
+// setLanguage shows the user interface in the language tagged tag, or in the
+// system language if tag is empty.
+func (win *Window) setLanguage(tag string) {
+	if tag == "" {
+		tag = i18n.SystemTag()
+	}
+	i18n.SetLocale(tag)
+}
+
+// rebuildPages redraws the window after the palette or language changed.
+func (win *Window) rebuildPages() {
+	// the pages are replaced, so wipe the secrets they hold first. The
+	// router opens the new page of the selected tab as if it was just
+	// switched to
+	seedgen.WipeSecrets()
+	win.registerPages()
+	win.navTabs.SelectID(win.router.Root())
+	win.window.Invalidate()
+}
+
 func (win *Window) Loop() {
 	var ops op.Ops
 	for {
@@ -76,7 +231,13 @@ func (win *Window) Loop() {
 		case e := <-win.window.Events():
 			switch e := e.(type) {
 			case system.DestroyEvent:
+				// don't leave secrets behind on the clipboard after exiting
+				win.clipboard.Clear()
+				seedgen.WipeSecrets()
 				return
+			case key.Event:
+				win.handleKey(e)
+				win.window.Invalidate()
 			case system.FrameEvent:
 				gtx := layout.NewContext(&ops, e)
 				win.drawWindow(gtx)
@@ -86,21 +247,107 @@ func (win *Window) Loop() {
 	}
 }
 
+// handleKey gives e to the page being shown first, keys it doesn't handle
+// are the window's shortcuts.
+func (win *Window) handleKey(e key.Event) {
+	page := win.router.Current()
+	if page != nil && page.HandleEvent(e) {
+		return
+	}
+
+	if e.Name == key.NameEscape {
+		win.router.Back()
+		return
+	}
+
+	if !e.Modifiers.Contain(key.ModShortcut) {
+		return
+	}
+
+	switch e.Name {
+	case "C":
+		if copier, ok := page.(ValueCopier); ok {
+			value, secret := copier.FocusedValue()
+			win.copyValue(copier, value, secret)
+		}
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		win.navTabs.Select(int(e.Name[0] - '1'))
+	}
+}
+
+// copyValue copies value to the clipboard, secrets go through the secret
+// clipboard so that they are cleared again. owner is the page the value was
+// copied from. A failed copy is reported, there is nothing else to show that
+// the shortcut did nothing.
+func (win *Window) copyValue(owner interface{}, value string, secret bool) {
+	if value == "" {
+		return
+	}
+	var err error
+	if secret {
+		err = win.clipboard.Copy(owner, value)
+	} else {
+		err = clipboard.WriteAll(value)
+	}
+	if err != nil {
+		win.notifier.Error(i18n.T("error copying to the clipboard: %s", err))
+	}
+}
+
 func (win *Window) drawWindow(gtx layout.Context) {
 	theme.ToMax(gtx)
 	theme.Fill(gtx, win.theme.Color.Background)
 
-	if win.navTabs.Changed() {
-		win.currentPage = win.navTabs.SelectedID()
-		win.isRenderingPage = false
+	layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return win.renderNetworkWarning(gtx)
+				}),
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return win.navTabs.Layout(gtx)
+				}),
+			)
+		}),
+		// notifications are drawn over whichever page is open
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return win.notifier.Layout(gtx, win.theme)
+		}),
+	)
+}
+
+// renderPage draws the page the router is showing. Switching tabs closes
+// the pages opened in the previous tab.
+func (win *Window) renderPage(gtx layout.Context) layout.Dimensions {
+	if id := win.navTabs.SelectedID(); id != win.router.Root() {
+		win.router.Reset(id)
 	}
+	return win.router.Layout(gtx)
+}
 
-	if page, ok := win.pages[win.currentPage]; ok {
-		if !win.isRenderingPage {
-			page.BeforeRender()
-		}
+func (win *Window) renderNetworkWarning(gtx layout.Context) layout.Dimensions {
+	if !secretPages[win.router.Root()] {
+		return layout.Dimensions{}
+	}
+
+	win.networkMu.Lock()
+	status, err := win.networkStatus, win.networkErr
+	win.networkMu.Unlock()
 
-		win.isRenderingPage = true
-		win.navTabs.Layout(gtx)
+	var warning string
+	switch {
+	case err != nil:
+		warning = i18n.T("Could not check whether this machine is online: %s", err)
+	case status == nil || !status.Online():
+		return layout.Dimensions{}
+	case helper.StrictAirGap():
+		warning = i18n.T("This machine is online (%s). Generating is disabled until it is disconnected from all networks.", status)
+	default:
+		warning = i18n.T("This machine is online (%s). Disconnect it from all networks before generating secrets you intend to keep.", status)
 	}
+
+	return layout.UniformInset(unit.Dp(10)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return win.theme.WarningAlert(gtx, warning)
+	})
 }